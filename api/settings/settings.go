@@ -208,6 +208,16 @@ type Settings struct {
 	// EnableAgentgateway enables kgateway to send config to Agentgateway
 	EnableAgentgateway bool `split_words:"true" default:"true"`
 
+	// EnableBuiltinRateLimit enables the built-in global rate limit service, served by the Envoy xDS server.
+	// GatewayExtensions of the RateLimit type can then use it instead of an external rate limit service,
+	// and ListenerPolicies can limit the connection rate of each client with perSourceRateLimit.
+	EnableBuiltinRateLimit bool `split_words:"true" default:"false"`
+
+	// BuiltinRateLimitRedisUrl is the URL of the Redis server storing the counters of the built-in rate limit service,
+	// in the `redis://[[username]:password@]host:port[/db]` format. The `rediss` scheme enables TLS.
+	// If not set, the counters are kept in memory and are not shared between the controller replicas.
+	BuiltinRateLimitRedisUrl string `split_words:"true"`
+
 	// WeightedRoutePrecedence enables routes with a larger weight to take precedence over routes with a smaller weight.
 	// If two routes have the same weight, Gateway API route precedence rules apply.
 	// When enabled, the default weight for a route is 0.
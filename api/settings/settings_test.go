@@ -40,6 +40,8 @@ func allEnvVarsSet() map[string]string {
 		"KGW_DISCOVERY_NAMESPACE_SELECTORS":            `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["infra"]}]},{"matchLabels":{"app":"a"}}]`,
 		"KGW_ENABLE_AGENTGATEWAY":                      "false",
 		"KGW_ENABLE_ENVOY":                             "false",
+		"KGW_ENABLE_BUILTIN_RATE_LIMIT":                "true",
+		"KGW_BUILTIN_RATE_LIMIT_REDIS_URL":             "redis://redis:6379",
 		"KGW_WEIGHTED_ROUTE_PRECEDENCE":                "true",
 		"KGW_VALIDATION_MODE":                          string(ValidationStrict),
 		"KGW_ENABLE_BUILTIN_DEFAULT_METRICS":           "true",
@@ -133,6 +135,8 @@ func TestSettings(t *testing.T) {
 				DiscoveryNamespaceSelectors:          `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["infra"]}]},{"matchLabels":{"app":"a"}}]`,
 				EnableAgentgateway:                   false,
 				EnableEnvoy:                          false,
+				EnableBuiltinRateLimit:               true,
+				BuiltinRateLimitRedisUrl:             "redis://redis:6379",
 				WeightedRoutePrecedence:              true,
 				ValidationMode:                       ValidationStrict,
 				EnableBuiltinDefaultMetrics:          true,
@@ -40,6 +40,7 @@ type BackendConfigPolicyList struct {
 // BackendConfigPolicySpec defines the desired state of BackendConfigPolicy.
 //
 // +kubebuilder:validation:AtMostOneOf=http1ProtocolOptions;http2ProtocolOptions
+// +kubebuilder:validation:XValidation:rule="!has(self.httpConnectProxy) || ((!has(self.targetRefs) || self.targetRefs.all(r, r.kind == 'Backend')) && (!has(self.targetSelectors) || self.targetSelectors.all(r, r.kind == 'Backend')))",message="httpConnectProxy can only be used when targeting Backend resources"
 type BackendConfigPolicySpec struct {
 	// TargetRefs specifies the target references to attach the policy to.
 	// +optional
@@ -104,11 +105,173 @@ type BackendConfigPolicySpec struct {
 	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/circuit_breaking) for more details.
 	// +optional
 	CircuitBreakers *CircuitBreakers `json:"circuitBreakers,omitempty"`
+
+	// ProxyProtocol configures sending the PROXY protocol header to the backend, so that the backend
+	// receives the address of the downstream client.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/proxy_protocol/v3/upstream_proxy_protocol.proto) for more details.
+	// +optional
+	ProxyProtocol *UpstreamProxyProtocol `json:"proxyProtocol,omitempty"`
+
+	// HTTPConnectProxy configures tunneling the connections to the backend through an HTTP/1.1 CONNECT proxy.
+	// Can only be used when the policy targets Backend resources, since the endpoints of Kubernetes
+	// Services are discovered with EDS and cannot carry the proxy address.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/http_11_proxy/v3/upstream_http_11_connect.proto) for more details.
+	// +optional
+	HTTPConnectProxy *HTTPConnectProxy `json:"httpConnectProxy,omitempty"`
+
+	// Dns configures how Envoy resolves the hostnames of the backend. Only applies to backends
+	// whose hosts are resolved by Envoy, such as Static and DnsSrv backends with hostnames and AWS backends.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/clusters/dns/v3/dns_cluster.proto) for more details.
+	// +optional
+	Dns *DnsConfig `json:"dns,omitempty"`
+}
+
+// DnsResolution is the DNS resolution mode of a backend.
+// +kubebuilder:validation:Enum=Strict;Logical
+type DnsResolution string
+
+const (
+	// DnsResolutionStrict creates an endpoint for every address that the hostnames resolve to.
+	DnsResolutionStrict DnsResolution = "Strict"
+	// DnsResolutionLogical only connects to the first address that the hostname resolves to,
+	// which is suited for large web services that return many addresses. Only supported by
+	// backends with a single host.
+	DnsResolutionLogical DnsResolution = "Logical"
+)
+
+// DnsLookupFamily is the IP family used when resolving hostnames.
+// +kubebuilder:validation:Enum=Auto;V4Only;V6Only;V4Preferred;All
+type DnsLookupFamily string
+
+const (
+	// DnsLookupFamilyAuto prefers IPv6 addresses, and falls back to IPv4 addresses.
+	DnsLookupFamilyAuto DnsLookupFamily = "Auto"
+	// DnsLookupFamilyV4Only only resolves IPv4 addresses.
+	DnsLookupFamilyV4Only DnsLookupFamily = "V4Only"
+	// DnsLookupFamilyV6Only only resolves IPv6 addresses.
+	DnsLookupFamilyV6Only DnsLookupFamily = "V6Only"
+	// DnsLookupFamilyV4Preferred prefers IPv4 addresses, and falls back to IPv6 addresses.
+	DnsLookupFamilyV4Preferred DnsLookupFamily = "V4Preferred"
+	// DnsLookupFamilyAll resolves both IPv4 and IPv6 addresses.
+	DnsLookupFamilyAll DnsLookupFamily = "All"
+)
+
+// DnsConfig configures the DNS resolution of a backend.
+type DnsConfig struct {
+	// Resolution is the DNS resolution mode. Defaults to Strict for Static and DnsSrv
+	// backends, and to Logical for AWS backends.
+	// +optional
+	Resolution *DnsResolution `json:"resolution,omitempty"`
+
+	// RefreshRate is the interval at which the hostnames are resolved. Defaults to 5s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="refreshRate must be at least 1ms"
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty"`
+
+	// RespectTTL uses the TTL of the DNS records as the refresh rate instead of RefreshRate.
+	// +optional
+	RespectTTL *bool `json:"respectTTL,omitempty"`
+
+	// FailureRefreshRate configures the exponential backoff used to refresh the hostnames
+	// after a resolution failure. When omitted, RefreshRate is used.
+	// +optional
+	FailureRefreshRate *DnsFailureRefreshRate `json:"failureRefreshRate,omitempty"`
+
+	// LookupFamily overrides the IP family used when resolving hostnames, which defaults
+	// to the dnsLookupFamily controller setting.
+	// +optional
+	LookupFamily *DnsLookupFamily `json:"lookupFamily,omitempty"`
+}
+
+// DnsFailureRefreshRate configures the backoff of DNS resolution after a failure.
+// +kubebuilder:validation:XValidation:rule="!has(self.maxInterval) || duration(self.maxInterval) >= duration(self.baseInterval)",message="maxInterval must be greater than or equal to baseInterval"
+type DnsFailureRefreshRate struct {
+	// BaseInterval is the base interval of the backoff.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="baseInterval must be at least 1ms"
+	BaseInterval metav1.Duration `json:"baseInterval"`
+
+	// MaxInterval is the maximum interval of the backoff. Defaults to 10 times BaseInterval.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
+
+// ProxyProtocolVersion is the version of the PROXY protocol.
+// +kubebuilder:validation:Enum=V1;V2
+type ProxyProtocolVersion string
+
+const (
+	// ProxyProtocolVersionV1 is the human-readable version 1 of the PROXY protocol.
+	ProxyProtocolVersionV1 ProxyProtocolVersion = "V1"
+	// ProxyProtocolVersionV2 is the binary version 2 of the PROXY protocol, which supports TLVs.
+	ProxyProtocolVersionV2 ProxyProtocolVersion = "V2"
+)
+
+// UpstreamProxyProtocol configures the PROXY protocol header sent to the backend.
+//
+// +kubebuilder:validation:XValidation:rule="self.version == 'V2' || (!has(self.addedTLVs) && !has(self.passThroughTLVs))",message="TLVs are only supported with PROXY protocol version V2"
+type UpstreamProxyProtocol struct {
+	// Version is the version of the PROXY protocol.
+	// +required
+	Version ProxyProtocolVersion `json:"version"`
+
+	// AddedTLVs is the list of the TLVs (type-length-value entries) added to the PROXY protocol header.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	AddedTLVs []ProxyProtocolTLV `json:"addedTLVs,omitempty"`
+
+	// PassThroughTLVs configures passing the TLVs received in the PROXY protocol header of the downstream
+	// connection through to the backend.
+	// +optional
+	PassThroughTLVs *ProxyProtocolPassThroughTLVs `json:"passThroughTLVs,omitempty"`
+}
+
+// ProxyProtocolTLV is a TLV (type-length-value entry) of the PROXY protocol version 2 header.
+type ProxyProtocolTLV struct {
+	// Type is the type of the TLV.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Type int32 `json:"type"`
+
+	// Value is the value of the TLV, sent as UTF-8 bytes.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Value string `json:"value"`
+}
+
+// ProxyProtocolPassThroughTLVs configures the downstream TLVs passed through to the backend.
+type ProxyProtocolPassThroughTLVs struct {
+	// Types is the list of the types of the TLVs passed through. If unset, all the TLVs are passed through.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=255
+	// +listType=set
+	Types []int32 `json:"types,omitempty"`
+}
+
+// HTTPConnectProxy configures tunneling the connections to the backend through an HTTP/1.1 CONNECT proxy.
+// Instead of connecting to the backend directly, Envoy connects to the proxy and sends a CONNECT request
+// with the address of the backend endpoint.
+// Only supported for backends with static endpoints, such as Backends of type Static.
+type HTTPConnectProxy struct {
+	// BackendRef references the Kubernetes Service of the proxy. The Service must have a cluster IP.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="(!has(self.group) || self.group == '') && (!has(self.kind) || self.kind == 'Service')",message="backendRef must reference a Kubernetes Service"
+	// +kubebuilder:validation:XValidation:rule="has(self.port)",message="backendRef port is required"
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
 }
 
 // CircuitBreakers contains the options to configure circuit breaker thresholds for the default priority.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto) for more details.
-// +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries
+// +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries;retryBudget
 type CircuitBreakers struct {
 	// MaxConnections is the maximum number of connections that will be made to
 	// the upstream cluster. If not specified, defaults to 1024.
@@ -133,6 +296,29 @@ type CircuitBreakers struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// RetryBudget limits the number of parallel retries to the upstream cluster to a
+	// proportion of the active requests, so that retries scale with the traffic and cannot
+	// cause a retry storm. If set, MaxRetries is ignored.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+}
+
+// RetryBudget limits the concurrent retries to a proportion of the active requests.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto#config-cluster-v3-circuitbreakers-thresholds-retrybudget) for more details.
+type RetryBudget struct {
+	// BudgetPercent is the limit on parallel retries as a percentage of the sum of
+	// active requests and active pending requests. If not specified, defaults to 20.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BudgetPercent *int32 `json:"budgetPercent,omitempty"`
+
+	// MinRetryConcurrency is the minimum number of parallel retries allowed by the
+	// retry budget, regardless of the number of active requests. If not specified, defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinRetryConcurrency *int32 `json:"minRetryConcurrency,omitempty"`
 }
 
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions) for more details.
@@ -333,7 +519,8 @@ type TLSFiles struct {
 	RootCA *string `json:"rootCA,omitempty"`
 }
 
-// +kubebuilder:validation:ExactlyOneOf=leastRequest;roundRobin;ringHash;maglev;random
+// +kubebuilder:validation:ExactlyOneOf=leastRequest;roundRobin;ringHash;maglev;random;clientSideWeightedRoundRobin
+// +kubebuilder:validation:XValidation:rule="has(self.clientSideWeightedRoundRobin) ? !has(self.localityType) : true",message="localityType is not supported with clientSideWeightedRoundRobin"
 type LoadBalancer struct {
 	// HealthyPanicThreshold configures envoy's panic threshold percentage between 0-100. Once the number of non-healthy hosts
 	// reaches this percentage, envoy disregards health information.
@@ -370,6 +557,11 @@ type LoadBalancer struct {
 	// +optional
 	Random *LoadBalancerRandomConfig `json:"random,omitempty"`
 
+	// ClientSideWeightedRoundRobin configures the client-side weighted round robin load balancer type,
+	// which weights the hosts using the ORCA load reports of the backends.
+	// +optional
+	ClientSideWeightedRoundRobin *LoadBalancerClientSideWeightedRoundRobinConfig `json:"clientSideWeightedRoundRobin,omitempty"`
+
 	// LocalityType specifies the locality config type to use.
 	// See https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/load_balancing_policies/common/v3/common.proto#envoy-v3-api-msg-extensions-load-balancing-policies-common-v3-localitylbconfig
 	// +optional
@@ -448,6 +640,50 @@ type LoadBalancerMaglevConfig struct {
 	HashPolicies []HashPolicy `json:"hashPolicies,omitempty"`
 }
 
+// LoadBalancerClientSideWeightedRoundRobinConfig configures the client-side weighted round robin
+// load balancer type. The weight of each host is calculated from the queries per second, error rate and
+// utilization reported by the host in the ORCA (Open Request Cost Aggregation) load reports attached
+// to its responses. Hosts that do not report their load are assigned the mean weight of the hosts.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/load_balancing_policies/client_side_weighted_round_robin/v3/client_side_weighted_round_robin.proto).
+type LoadBalancerClientSideWeightedRoundRobinConfig struct {
+	// BlackoutPeriod is the time during which the weight of a host that starts reporting its load is not
+	// used yet, so that the weight is not computed from too few load reports. Defaults to 10s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	BlackoutPeriod *metav1.Duration `json:"blackoutPeriod,omitempty"`
+
+	// WeightExpirationPeriod is the time after which the weight of a host that stopped reporting its load
+	// expires. The blackout period applies again once the host reports its load. Defaults to 3m.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	WeightExpirationPeriod *metav1.Duration `json:"weightExpirationPeriod,omitempty"`
+
+	// WeightUpdatePeriod is the time between two recalculations of the weights. Defaults to 1s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('100ms')",message="weightUpdatePeriod must be at least 100ms"
+	WeightUpdatePeriod *metav1.Duration `json:"weightUpdatePeriod,omitempty"`
+
+	// ErrorUtilizationPenalty is the multiplier applied to the error rate of a host, relative to its
+	// queries per second, when calculating its weight. Higher values penalize the hosts returning errors
+	// more. Must be at least 0.0. Defaults to 1.0.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="(self.matches('^(?:[0-9]+(?:\\\\.[0-9]*)?|\\\\.[0-9]+)$') && double(self) >= 0.0)",message="errorUtilizationPenalty must be a string representing a number of at least 0.0"
+	ErrorUtilizationPenalty *string `json:"errorUtilizationPenalty,omitempty"`
+
+	// MetricNamesForComputingUtilization is the list of the ORCA named metrics used as the utilization
+	// of a host when its application utilization is not reported, e.g. "named_metrics.foo". The highest
+	// value among the metrics is used. If unset, the CPU utilization is used.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	MetricNamesForComputingUtilization []string `json:"metricNamesForComputingUtilization,omitempty"`
+
+	// SlowStart configures the slow start configuration for the load balancer.
+	// +optional
+	SlowStart *SlowStart `json:"slowStart,omitempty"`
+}
+
 type (
 	LoadBalancerRandomConfig struct{}
 	SlowStart                struct {
@@ -494,7 +730,7 @@ const (
 // HealthCheck contains the options to configure the health check.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/health_check.proto) for more details.
 
-// +kubebuilder:validation:XValidation:rule="has(self.http) != has(self.grpc)",message="exactly one of http or grpc must be set"
+// +kubebuilder:validation:ExactlyOneOf=http;grpc;tcp
 type HealthCheck struct {
 	// Timeout is time to wait for a health check response. If the timeout is reached the
 	// health check attempt will be considered a failure.
@@ -522,6 +758,26 @@ type HealthCheck struct {
 	// +kubebuilder:validation:Minimum=0
 	HealthyThreshold int32 `json:"healthyThreshold"`
 
+	// NoTrafficInterval is the time between health checks of a host while the cluster has not
+	// yet received traffic. Defaults to 60s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	NoTrafficInterval *metav1.Duration `json:"noTrafficInterval,omitempty"`
+
+	// UnhealthyEdgeInterval is the time before the next health check of a host that has just been
+	// marked unhealthy. Defaults to the interval.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	UnhealthyEdgeInterval *metav1.Duration `json:"unhealthyEdgeInterval,omitempty"`
+
+	// EventLogPath is the path of the file where the health check events, such as a host being
+	// ejected or added back, are logged. Must be either /dev/stdout or a file directly in the
+	// /var/log/envoy directory, which can be mounted into the proxy with extraVolumeMounts.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(/dev/stdout|/var/log/envoy/[A-Za-z0-9][A-Za-z0-9._-]*)$`
+	EventLogPath *string `json:"eventLogPath,omitempty"`
+
 	// Http contains the options to configure the HTTP health check.
 	// +optional
 	Http *HealthCheckHttp `json:"http,omitempty"`
@@ -529,6 +785,10 @@ type HealthCheck struct {
 	// Grpc contains the options to configure the gRPC health check.
 	// +optional
 	Grpc *HealthCheckGrpc `json:"grpc,omitempty"`
+
+	// Tcp contains the options to configure the TCP health check.
+	// +optional
+	Tcp *HealthCheckTcp `json:"tcp,omitempty"`
 }
 type HealthCheckHttp struct {
 	// Host is the value of the host header in the HTTP health check request. If
@@ -546,6 +806,13 @@ type HealthCheckHttp struct {
 	// +optional
 	// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;DELETE;OPTIONS;TRACE;PATCH
 	Method *string `json:"method,omitempty"`
+
+	// ExpectedStatuses is the list of HTTP status code ranges of the healthy responses.
+	// If unset, only 200 responses are healthy.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ExpectedStatuses []HTTPStatusRange `json:"expectedStatuses,omitempty"`
 }
 
 type HealthCheckGrpc struct {
@@ -560,6 +827,38 @@ type HealthCheckGrpc struct {
 	Authority *string `json:"authority,omitempty"`
 }
 
+// HealthCheckTcp configures a TCP health check. If neither Send nor Receive is set, the
+// health check only verifies that a connection can be established.
+type HealthCheckTcp struct {
+	// Send is the payload sent to the host after the connection is established.
+	// +optional
+	Send *HealthCheckPayload `json:"send,omitempty"`
+
+	// Receive is the list of payloads expected in the response. The health check succeeds
+	// when all the payloads are found in the response, in order.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Receive []HealthCheckPayload `json:"receive,omitempty"`
+}
+
+// HealthCheckPayload is a payload sent or expected by a health check.
+//
+// +kubebuilder:validation:ExactlyOneOf=text;hex
+type HealthCheckPayload struct {
+	// Text is the payload as plain text, e.g. "PING\r\n".
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Text *string `json:"text,omitempty"`
+
+	// Hex is the payload as a hex-encoded string, e.g. "50494E470D0A".
+	// +optional
+	// +kubebuilder:validation:MaxLength=8192
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2})+$`
+	Hex *string `json:"hex,omitempty"`
+}
+
 // OutlierDetection contains the options to configure passive health checks.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/outlier#outlier-detection) for more details.
 
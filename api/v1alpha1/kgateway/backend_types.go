@@ -38,16 +38,22 @@ const (
 	BackendTypeStatic BackendType = "Static"
 	// BackendTypeDynamicForwardProxy is the type for dynamic forward proxy backends.
 	BackendTypeDynamicForwardProxy BackendType = "DynamicForwardProxy"
+	// BackendTypeFailover is the type for failover backends.
+	BackendTypeFailover BackendType = "Failover"
+	// BackendTypeDnsSrv is the type for backends whose hosts are resolved from DNS SRV records.
+	BackendTypeDnsSrv BackendType = "DnsSrv"
 )
 
 // BackendSpec defines the desired state of Backend.
 // +kubebuilder:validation:XValidation:message="aws backend must be specified when type is 'AWS'",rule="self.type == 'AWS' ? has(self.aws) : true"
 // +kubebuilder:validation:XValidation:message="static backend must be specified when type is 'Static'",rule="self.type == 'Static' ? has(self.static) : true"
 // +kubebuilder:validation:XValidation:message="dynamicForwardProxy backend must be specified when type is 'DynamicForwardProxy'",rule="self.type == 'DynamicForwardProxy' ? has(self.dynamicForwardProxy) : true"
-// +kubebuilder:validation:ExactlyOneOf=aws;static;dynamicForwardProxy
+// +kubebuilder:validation:XValidation:message="failover backend must be specified when type is 'Failover'",rule="self.type == 'Failover' ? has(self.failover) : true"
+// +kubebuilder:validation:XValidation:message="dnsSrv backend must be specified when type is 'DnsSrv'",rule="self.type == 'DnsSrv' ? has(self.dnsSrv) : true"
+// +kubebuilder:validation:ExactlyOneOf=aws;static;dynamicForwardProxy;failover;dnsSrv
 type BackendSpec struct {
 	// Type indicates the type of the backend to be used.
-	// +kubebuilder:validation:Enum=AWS;Static;DynamicForwardProxy
+	// +kubebuilder:validation:Enum=AWS;Static;DynamicForwardProxy;Failover;DnsSrv
 	// +required
 	Type BackendType `json:"type"`
 	// Aws is the AWS backend configuration.
@@ -60,6 +66,14 @@ type BackendSpec struct {
 	// DynamicForwardProxy is the dynamic forward proxy backend configuration.
 	// +optional
 	DynamicForwardProxy *DynamicForwardProxyBackend `json:"dynamicForwardProxy,omitempty"`
+	// Failover is the failover backend configuration.
+	// The Failover backend type is only supported with envoy-based gateways, it is not supported in agentgateway.
+	// +optional
+	Failover *FailoverBackend `json:"failover,omitempty"`
+	// DnsSrv is the DNS SRV backend configuration.
+	// The DnsSrv backend type is only supported with envoy-based gateways, it is not supported in agentgateway.
+	// +optional
+	DnsSrv *DnsSrvBackend `json:"dnsSrv,omitempty"`
 }
 
 // AppProtocol defines the application protocol to use when communicating with the backend.
@@ -80,25 +94,181 @@ const (
 )
 
 // DynamicForwardProxyBackend is the dynamic forward proxy backend configuration.
+// All fields except enableTls are only supported with envoy-based gateways, they are not supported in agentgateway.
+// +kubebuilder:validation:XValidation:message="dnsCache can only be set when mode is 'DnsCache'",rule="!has(self.dnsCache) || (has(self.mode) && self.mode == 'DnsCache')"
+// +kubebuilder:validation:XValidation:message="allowedPorts can only be set when allowedHosts is set",rule="!has(self.allowedPorts) || has(self.allowedHosts)"
 type DynamicForwardProxyBackend struct {
 	// EnableTls enables TLS. When true, the backend will be configured to use TLS. System CA will be used for validation.
 	// The hostname will be used for SNI and auto SAN validation.
 	// +optional
 	EnableTls *bool `json:"enableTls,omitempty"`
+
+	// Mode is how the hosts that requests are forwarded to are resolved. Defaults to SubCluster.
+	// +optional
+	Mode *DynamicForwardProxyMode `json:"mode,omitempty"`
+
+	// AllowedHosts restricts the hosts that requests can be forwarded to. When set, requests
+	// to hosts that don't match any of the entries, or to a port that is not in AllowedPorts,
+	// are denied with a 403 response.
+	// The host is matched against the authority of the request, without the port, before the
+	// request is routed. Routes to a backend with allowed or denied hosts therefore can't rewrite
+	// the host, and requests can't be mirrored to it, which is reported on the status of the route.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	AllowedHosts []DynamicForwardProxyHostMatch `json:"allowedHosts,omitempty"`
+
+	// AllowedPorts is the ports of the allowed hosts that requests can be forwarded to.
+	// Requests without a port in the authority are always allowed, since they are forwarded
+	// to port 80, or 443 when TLS is enabled. Defaults to 80 and 443.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=65535
+	AllowedPorts []int32 `json:"allowedPorts,omitempty"`
+
+	// DeniedHosts lists the hosts that requests can't be forwarded to. Requests to hosts that
+	// match any of the entries are denied with a 403 response, even if they match AllowedHosts.
+	// The host is matched against the authority of the request, without the port, like for AllowedHosts.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	DeniedHosts []DynamicForwardProxyHostMatch `json:"deniedHosts,omitempty"`
+
+	// MaxHosts is the maximum number of hosts that are resolved at the same time. Requests to
+	// new hosts fail once the limit is reached. Defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxHosts *int32 `json:"maxHosts,omitempty"`
+
+	// HostTTL is how long a host that receives no requests is kept before it's removed,
+	// which bounds how long stale DNS results of unused hosts are kept. Defaults to 5m.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="hostTTL must be at least 1ms"
+	HostTTL *metav1.Duration `json:"hostTTL,omitempty"`
+
+	// DnsCache configures the DNS cache that is used when mode is DnsCache.
+	// +optional
+	DnsCache *DynamicForwardProxyDnsCache `json:"dnsCache,omitempty"`
+}
+
+// DynamicForwardProxyMode is how a dynamic forward proxy resolves hosts.
+// +kubebuilder:validation:Enum=SubCluster;DnsCache
+type DynamicForwardProxyMode string
+
+const (
+	// DynamicForwardProxyModeSubCluster creates a cluster for every host, so that every host
+	// has its own connection pool, load balancing across its addresses, and TLS context
+	// with the SNI and verified SAN of the host.
+	DynamicForwardProxyModeSubCluster DynamicForwardProxyMode = "SubCluster"
+	// DynamicForwardProxyModeDnsCache resolves hosts with a DNS cache that is shared by
+	// all hosts of the backend.
+	DynamicForwardProxyModeDnsCache DynamicForwardProxyMode = "DnsCache"
+)
+
+// DynamicForwardProxyHostMatchType is how a host is matched.
+// +kubebuilder:validation:Enum=Exact;Glob;RegularExpression
+type DynamicForwardProxyHostMatchType string
+
+const (
+	// DynamicForwardProxyHostMatchExact matches the host exactly, ignoring case.
+	DynamicForwardProxyHostMatchExact DynamicForwardProxyHostMatchType = "Exact"
+	// DynamicForwardProxyHostMatchGlob matches the host against a pattern, ignoring case,
+	// where '*' matches any sequence of characters, e.g. '*.example.com'.
+	DynamicForwardProxyHostMatchGlob DynamicForwardProxyHostMatchType = "Glob"
+	// DynamicForwardProxyHostMatchRegularExpression matches the whole host against an RE2 regular expression.
+	DynamicForwardProxyHostMatchRegularExpression DynamicForwardProxyHostMatchType = "RegularExpression"
+)
+
+// DynamicForwardProxyHostMatch matches the host of a request.
+type DynamicForwardProxyHostMatch struct {
+	// Type is how the host is matched. Defaults to Glob.
+	// +optional
+	Type *DynamicForwardProxyHostMatchType `json:"type,omitempty"`
+
+	// Value is the host, pattern or regular expression to match.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Value string `json:"value"`
+}
+
+// DynamicForwardProxyDnsCache configures the DNS cache of a dynamic forward proxy.
+type DynamicForwardProxyDnsCache struct {
+	// RefreshRate is the interval at which the cached hosts are resolved again. Defaults to 60s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="refreshRate must be at least 1ms"
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty"`
+
+	// MaxPendingRequests is the maximum number of requests that wait for a host to be resolved.
+	// Requests that exceed the limit overflow the circuit breaker of the DNS cache and fail
+	// with a 503 response. Defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxPendingRequests *int32 `json:"maxPendingRequests,omitempty"`
+}
+
+// FailoverBackend is the failover backend configuration. Traffic is sent to the first
+// backend in the list that has healthy hosts, and spills over to the next backend when
+// the previous one has no healthy hosts left.
+type FailoverBackend struct {
+	// BackendRefs is the ordered list of backends to fail over between.
+	// Supported kinds are Kubernetes Services and Backends of type AWS or Static.
+	// A port is required when referencing a Service.
+	// BackendRefs that can't be resolved are reported on the status of the Backend and skipped,
+	// and the Backend is rejected if none of them can be resolved.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="port is required when referencing a Service",rule="self.all(r, (has(r.kind) && r.kind != 'Service') || has(r.port))"
+	BackendRefs []gwv1.BackendObjectReference `json:"backendRefs"`
+}
+
+// DnsSrvBackend resolves the hosts of the backend from DNS SRV records, as used by DNS-based
+// service discovery. The records are resolved by the controller, and every record becomes a
+// host of the backend with the target, port, priority and weight of the record. The targets
+// are then resolved by Envoy, which can be tuned with the dns field of BackendConfigPolicy.
+type DnsSrvBackend struct {
+	// Name is the fully qualified name of the SRV records, e.g. _http._tcp.legacy.example.com.
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^_[A-Za-z0-9-]+\\._[A-Za-z0-9-]+(\\.[A-Za-z0-9-]+)+\\.?$"
+	Name string `json:"name"`
+
+	// RefreshInterval is the interval at which the SRV records are resolved. Defaults to 30s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="refreshInterval must be at least 1s"
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// AppProtocol is the application protocol to use when communicating with the backend.
+	// +optional
+	AppProtocol *AppProtocol `json:"appProtocol,omitempty"`
 }
 
 // AwsBackend is the AWS backend configuration.
+// +kubebuilder:validation:ExactlyOneOf=lambda;service
+// +kubebuilder:validation:XValidation:message="accountId must be specified when lambda is specified",rule="has(self.lambda) ? has(self.accountId) : true"
 type AwsBackend struct {
 	// Lambda configures the AWS lambda service.
-	// +required
-	Lambda AwsLambda `json:"lambda"`
+	// It is required unless service is set.
+	// +optional
+	Lambda AwsLambda `json:"lambda,omitzero"`
+
+	// Service configures SigV4 request signing for an arbitrary AWS service,
+	// such as S3, OpenSearch or private API Gateway endpoints.
+	// +optional
+	Service *AwsService `json:"service,omitempty"`
 
 	// AccountId is the AWS account ID to use for the backend.
-	// +required
+	// Required when Lambda is specified.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=12
 	// +kubebuilder:validation:Pattern="^[0-9]{12}$"
-	AccountId string `json:"accountId"`
+	AccountId string `json:"accountId,omitempty"`
 
 	// Auth specifies an explicit AWS authentication method for the backend.
 	// When omitted, the following credential providers are tried in order, stopping when one
@@ -129,20 +299,55 @@ type AwsAuthType string
 const (
 	// AwsAuthTypeSecret uses credentials stored in a Kubernetes Secret.
 	AwsAuthTypeSecret AwsAuthType = "Secret"
+	// AwsAuthTypeWebIdentity exchanges a projected service account token for temporary
+	// credentials of an IAM role with AssumeRoleWithWebIdentity (IRSA).
+	AwsAuthTypeWebIdentity AwsAuthType = "WebIdentity"
+	// AwsAuthTypePodIdentity uses EKS Pod Identity to obtain temporary credentials.
+	AwsAuthTypePodIdentity AwsAuthType = "PodIdentity"
+	// AwsAuthTypeInstanceMetadata uses the credentials of the instance profile of the node,
+	// obtained from the EC2 instance metadata service (IMDS).
+	AwsAuthTypeInstanceMetadata AwsAuthType = "InstanceMetadata"
 )
 
 // AwsAuth specifies the authentication method to use for the backend.
 // +kubebuilder:validation:XValidation:message="secretRef must be nil if the type is not 'Secret'",rule="!(has(self.secretRef) && self.type != 'Secret')"
 // +kubebuilder:validation:XValidation:message="secretRef must be specified when type is 'Secret'",rule="!(!has(self.secretRef) && self.type == 'Secret')"
+// +kubebuilder:validation:XValidation:message="webIdentity must be specified if and only if the type is 'WebIdentity'",rule="has(self.webIdentity) == (self.type == 'WebIdentity')"
 type AwsAuth struct {
 	// Type specifies the authentication method to use for the backend.
 	// +required
-	// +kubebuilder:validation:Enum=Secret
+	// +kubebuilder:validation:Enum=Secret;WebIdentity;PodIdentity;InstanceMetadata
 	Type AwsAuthType `json:"type"`
 	// SecretRef references a Kubernetes Secret containing the AWS credentials.
 	// The Secret must have keys "accessKey", "secretKey", and optionally "sessionToken".
 	// +optional
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// WebIdentity configures the IAM role to assume with a web identity token.
+	// The token can be projected into the proxy pods with the kube.aws.webIdentity
+	// field of the GatewayParameters.
+	// +optional
+	WebIdentity *AwsWebIdentityAuth `json:"webIdentity,omitempty"`
+}
+
+// AwsWebIdentityAuth configures AssumeRoleWithWebIdentity authentication.
+type AwsWebIdentityAuth struct {
+	// RoleArn is the ARN of the IAM role to assume.
+	// +required
+	// +kubebuilder:validation:Pattern="^arn:aws[a-z-]*:iam::[0-9]{12}:role/[A-Za-z0-9+=,.@_/-]+$"
+	// +kubebuilder:validation:MaxLength=2048
+	RoleArn string `json:"roleArn"`
+	// RoleSessionName is the name of the session of the assumed role.
+	// When omitted, Envoy generates a session name.
+	// +optional
+	// +kubebuilder:validation:Pattern="^[A-Za-z0-9+=,.@_-]{2,64}$"
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+	// TokenPath is the path of the web identity token file in the proxy container.
+	// Defaults to /var/run/secrets/eks.amazonaws.com/serviceaccount/token, where the token
+	// is projected by the EKS pod identity webhook and by the GatewayParameters.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	TokenPath *string `json:"tokenPath,omitempty"`
 }
 
 const (
@@ -185,6 +390,29 @@ type AwsLambda struct {
 	PayloadTransformMode AWSLambdaPayloadTransformMode `json:"payloadTransformMode,omitempty"`
 }
 
+// AwsService configures SigV4 request signing for an arbitrary AWS service.
+type AwsService struct {
+	// Name is the signing name of the AWS service, for example "s3", "es" for
+	// OpenSearch, "aoss" for OpenSearch Serverless or "execute-api" for API Gateway.
+	// +required
+	// +kubebuilder:validation:Pattern="^[a-z0-9-]{1,64}$"
+	Name string `json:"name"`
+	// EndpointURL is the URL of the service endpoint, for example the endpoint of an
+	// OpenSearch domain or of a VPC endpoint. The port defaults to 443 for https and to
+	// 80 for http. When omitted, https://<name>.<region>.amazonaws.com will be used.
+	// The Host header of the request is rewritten to the endpoint hostname before signing.
+	// +optional
+	// +kubebuilder:validation:Pattern="^https?://[-a-zA-Z0-9@:%.+~#?&/=]+$"
+	// +kubebuilder:validation:MaxLength=2048
+	EndpointURL *string `json:"endpointURL,omitempty"`
+	// UnsignedPayload signs requests with the UNSIGNED-PAYLOAD content hash instead of
+	// the hash of the request body, so that the body does not need to be buffered before
+	// it is sent. Only supported by some services, such as S3.
+	// Defaults to false.
+	// +optional
+	UnsignedPayload *bool `json:"unsignedPayload,omitempty"`
+}
+
 // AWSLambdaPayloadTransformMode defines the transformation mode for the payload in the request
 // before it is sent to the AWS Lambda function.
 //
@@ -225,6 +453,45 @@ type Host struct {
 	// Port is the port to use for the backend.
 	// +required
 	Port gwv1.PortNumber `json:"port"`
+	// Weight is the load balancing weight of the host relative to the other hosts
+	// with the same priority and locality. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	Weight *int32 `json:"weight,omitempty"`
+	// Priority is the priority tier of the host. Lower values have higher priority.
+	// Traffic is only sent to hosts of a tier when the hosts of the higher priority
+	// tiers are unhealthy, e.g. because they fail health checks or are ejected by
+	// outlier detection. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	Priority *int32 `json:"priority,omitempty"`
+	// Locality is the locality of the host. Used for locality weighted load
+	// balancing, see the LoadBalancer.LocalityType field of BackendConfigPolicy.
+	// +optional
+	Locality *HostLocality `json:"locality,omitempty"`
+	// Metadata is arbitrary metadata of the host. It is set as the envoy.lb filter
+	// metadata of the endpoint, which can be used by load balancers and in access logs.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// HostLocality identifies where a static backend host is located.
+type HostLocality struct {
+	// Region is the region of the host.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Region string `json:"region,omitempty"`
+	// Zone is the zone of the host.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Zone string `json:"zone,omitempty"`
+	// SubZone is the sub-zone of the host.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	SubZone string `json:"subZone,omitempty"`
 }
 
 // BackendStatus defines the observed state of Backend.
@@ -0,0 +1,47 @@
+package kgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// BandwidthLimit limits the bandwidth of request and response bodies. The limits apply to each
+// Envoy proxy replica and are shared by all requests of the policy targets.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/bandwidth_limit_filter
+//
+// +kubebuilder:validation:AtLeastOneOf=requestLimitKiBps;responseLimitKiBps;disable
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.requestLimitKiBps) && !has(self.responseLimitKiBps) && !has(self.fillInterval) && !has(self.runtimeKey) : true",message="disable cannot be set together with other bandwidthLimit fields"
+type BandwidthLimit struct {
+	// RequestLimitKiBps is the maximum bandwidth of request bodies, in KiB per second.
+	// If unset, request bodies are not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RequestLimitKiBps *int32 `json:"requestLimitKiBps,omitempty"`
+
+	// ResponseLimitKiBps is the maximum bandwidth of response bodies, in KiB per second.
+	// If unset, response bodies are not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ResponseLimitKiBps *int32 `json:"responseLimitKiBps,omitempty"`
+
+	// FillInterval is the interval at which the token bucket of the limit is refilled.
+	// Must be between 20ms and 1s. Defaults to 50ms.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('20ms') && duration(self) <= duration('1s')",message="fillInterval must be between 20ms and 1s"
+	FillInterval *metav1.Duration `json:"fillInterval,omitempty"`
+
+	// RuntimeKey is the name of an Envoy runtime key that enables or disables the limits at runtime.
+	// The limits are enabled if the key is not set in the runtime.
+	// Note that Envoy only supports turning the limits on or off, not enabling them for a percentage of requests.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	RuntimeKey *string `json:"runtimeKey,omitempty"`
+
+	// Disable bandwidth limiting.
+	// Can be used to disable bandwidth limit policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
@@ -0,0 +1,89 @@
+package kgateway
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// Cache configures caching of upstream responses in the memory of the proxy.
+// Responses are cached following the HTTP caching rules of RFC 9111: only responses to GET and HEAD
+// requests whose status code and Cache-Control headers allow it are stored.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/cache_filter
+//
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.varyHeaders) && !has(self.cacheKey) && !has(self.maxBodySize) : true",message="disable cannot be set together with varyHeaders, cacheKey or maxBodySize"
+type Cache struct {
+	// VaryHeaders is the allowlist of request headers that responses may vary on.
+	// Responses with a Vary header naming a header that is not in this list are not cached.
+	// Responses that vary on an allowed header are cached separately for each value of the header,
+	// which makes the header part of the cache key.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	VaryHeaders []gwv1.HTTPHeaderName `json:"varyHeaders,omitempty"`
+
+	// CacheKey customizes the key that responses are cached under.
+	// By default, the key is made of the scheme, host, path and all query parameters of the request.
+	// +optional
+	CacheKey *CacheKey `json:"cacheKey,omitempty"`
+
+	// MaxBodySize is the maximum size of a response body that is cached.
+	// Responses with larger bodies are not cached.
+	// Example format: "1Mi", "512Ki"
+	// +optional
+	// +kubebuilder:validation:XValidation:message="maxBodySize must be greater than 0 and less than 4Gi",rule="(type(self) == int && int(self) > 0 && int(self) < 4294967296) || (type(self) == string && quantity(self).isGreaterThan(quantity('0')) && quantity(self).isLessThan(quantity('4Gi')))"
+	MaxBodySize *resource.Quantity `json:"maxBodySize,omitempty"`
+
+	// Disable response caching.
+	// Can be used to disable cache policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// CacheKey customizes the cache key of responses.
+type CacheKey struct {
+	// ExcludeScheme excludes the request scheme from the cache key, so that
+	// HTTP and HTTPS requests share cached responses.
+	// +optional
+	ExcludeScheme bool `json:"excludeScheme,omitempty"`
+
+	// ExcludeHost excludes the request host from the cache key, so that
+	// requests for different hosts share cached responses.
+	// +optional
+	ExcludeHost bool `json:"excludeHost,omitempty"`
+
+	// QueryParameters selects the query parameters that are part of the cache key.
+	// If unset, all query parameters are part of the cache key.
+	// +optional
+	QueryParameters *CacheKeyQueryParameters `json:"queryParameters,omitempty"`
+}
+
+// CacheKeyQueryParameters selects the query parameters that are part of the cache key.
+// +kubebuilder:validation:ExactlyOneOf=include;exclude
+type CacheKeyQueryParameters struct {
+	// Include lists the only query parameters that are part of the cache key.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists the query parameters that are not part of the cache key.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+const (
+	// TrafficPolicyConditionCacheable is reported on an ancestor of a TrafficPolicy
+	// with a cache policy that targets routes whose responses can never be cached.
+	TrafficPolicyConditionCacheable shared.PolicyConditionType = "Cacheable"
+
+	// TrafficPolicyReasonUncacheableRoute is used with the "Cacheable" condition when
+	// the policy targets routes that only match request methods other than GET and HEAD,
+	// or that never forward requests upstream.
+	TrafficPolicyReasonUncacheableRoute shared.PolicyConditionReason = "UncacheableRoute"
+)
@@ -0,0 +1,113 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// ErrorPages replaces the local replies generated by the proxy by custom responses, e.g. to serve
+// branded error pages for 404 when no route matches, 503 when no upstream is available, 429 from rate limiting,
+// or 401 and 403 from authentication and authorization. Responses returned by upstreams are never replaced.
+// Error pages attached to a Gateway or listener apply to all the local replies of the listener, including those
+// of requests that match no route, and error pages attached to a route replace them for the requests of the route.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/local_reply
+//
+// +kubebuilder:validation:AtLeastOneOf=rules;disable
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.rules) : true",message="disable cannot be set together with rules"
+type ErrorPages struct {
+	// Rules are evaluated in order, and the first rule matching a local reply is applied.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Rules []ErrorPageRule `json:"rules,omitempty"`
+
+	// Disable error pages.
+	// Can be used to disable error page policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ErrorPageRule replaces the local replies with the given status codes and response flags.
+// If both are set, a local reply must match both.
+//
+// +kubebuilder:validation:AtLeastOneOf=statusCodes;responseFlags
+// +kubebuilder:validation:AtLeastOneOf=statusCode;body;headers
+// +kubebuilder:validation:XValidation:rule="has(self.contentType) ? has(self.body) : true",message="contentType requires body to be set"
+type ErrorPageRule struct {
+	// StatusCodes are the status codes of the local replies the rule applies to.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Minimum=400
+	// +kubebuilder:validation:items:Maximum=599
+	StatusCodes []int32 `json:"statusCodes,omitempty"`
+
+	// ResponseFlags are the Envoy response flags of the local replies the rule applies to, e.g. `NR` when
+	// no route matches, `UH` when no healthy upstream is available, `RL` when the request is rate limited,
+	// or `UAEX` when the request is denied by external authorization. A local reply matches if it has any of the flags.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	ResponseFlags []ErrorPageResponseFlag `json:"responseFlags,omitempty"`
+
+	// StatusCode overrides the status code of the response. If unset, the status code is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Body replaces the body of the response. The body supports Envoy format substitution,
+	// e.g. `%RESPONSE_CODE%`, `%RESPONSE_CODE_DETAILS%` or `%REQ(x-request-id)%`, so a literal `%`
+	// must be escaped as `%%`. If unset, the body is kept.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#command-operators
+	// +optional
+	Body *ErrorPageBody `json:"body,omitempty"`
+
+	// ContentType is the content type of the body. Defaults to `text/plain`.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Headers are set on the response, replacing existing headers with the same name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeader `json:"headers,omitempty"`
+}
+
+// ErrorPageResponseFlag is the short name of an Envoy response flag, giving the reason of a local reply.
+// +kubebuilder:validation:Enum=LH;UH;UT;LR;UR;UF;UC;UO;NR;DI;FI;RL;UAEX;RLSE;DC;URX;SI;IH;DPE;UMSDR;RFCF;NFCF;DT;UPE;NC;OM;DF;DO;DR;UDO
+type ErrorPageResponseFlag string
+
+// ErrorPageBody is the source of the body of an error page.
+// +kubebuilder:validation:ExactlyOneOf=inline;configMap
+type ErrorPageBody struct {
+	// Inline is the body.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=16384
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMap loads the body from a ConfigMap in the same namespace as the policy.
+	// +optional
+	ConfigMap *ErrorPageConfigMapSource `json:"configMap,omitempty"`
+}
+
+// ErrorPageConfigMapSource references the body of an error page stored in a ConfigMap.
+type ErrorPageConfigMapSource struct {
+	// Name is the name of the ConfigMap.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the data key of the ConfigMap that contains the body.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+}
@@ -0,0 +1,120 @@
+package kgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// FaultInjection configures the injection of faults into requests. Faults can be used to
+// test the resiliency of an application to delayed, failed, or slow responses from its upstreams.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/fault_filter
+//
+// +kubebuilder:validation:AtLeastOneOf=delay;abort;responseRateLimit;disable
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.delay) && !has(self.abort) && !has(self.responseRateLimit) : true",message="disable cannot be set together with delay, abort or responseRateLimit"
+type FaultInjection struct {
+	// Delay injects a delay before the request is forwarded upstream.
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort aborts the request with the configured HTTP or gRPC status instead of forwarding it upstream.
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+
+	// ResponseRateLimit limits the rate at which the response body is sent to the downstream client.
+	// +optional
+	ResponseRateLimit *FaultResponseRateLimit `json:"responseRateLimit,omitempty"`
+
+	// Headers restricts fault injection to requests that match all of the given headers.
+	// If unset, faults are injected into all requests.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers,omitempty"`
+
+	// MaxActiveFaults is the maximum number of faults that can be active at a single time
+	// across all requests handled by the Envoy proxy. If unset, there is no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxActiveFaults *int32 `json:"maxActiveFaults,omitempty"`
+
+	// Disable fault injection.
+	// Can be used to disable fault injection policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// FaultDelay configures the delay injected into a request.
+// +kubebuilder:validation:ExactlyOneOf=fixedDelay;fromHeader
+type FaultDelay struct {
+	// FixedDelay is the amount of time the request is delayed by.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="delay.fixedDelay must be at least 1ms."
+	FixedDelay *metav1.Duration `json:"fixedDelay,omitempty"`
+
+	// FromHeader delays the request by the number of milliseconds given in the
+	// `x-envoy-fault-delay-request` request header. Requests without the header are not delayed.
+	// +optional
+	FromHeader *FaultFromHeader `json:"fromHeader,omitempty"`
+
+	// Percentage of requests the delay is injected into.
+	// Defaults to 100 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultAbort configures how a request is aborted.
+// +kubebuilder:validation:ExactlyOneOf=httpStatus;grpcStatus;fromHeader
+type FaultAbort struct {
+	// HttpStatus is the HTTP status code used to abort the request.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HttpStatus *int32 `json:"httpStatus,omitempty"`
+
+	// GrpcStatus is the gRPC status code used to abort the request.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	GrpcStatus *int32 `json:"grpcStatus,omitempty"`
+
+	// FromHeader aborts the request with the HTTP status code given in the `x-envoy-fault-abort-request`
+	// request header, or the gRPC status code given in the `x-envoy-fault-abort-grpc-request` request header.
+	// Requests without either header are not aborted.
+	// +optional
+	FromHeader *FaultFromHeader `json:"fromHeader,omitempty"`
+
+	// Percentage of requests that are aborted.
+	// Defaults to 100 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultResponseRateLimit configures the rate limit applied to the response body.
+// +kubebuilder:validation:ExactlyOneOf=kbps;fromHeader
+type FaultResponseRateLimit struct {
+	// Kbps is the rate limit in KiB/s that the response body is sent at.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Kbps *int32 `json:"kbps,omitempty"`
+
+	// FromHeader limits the response rate to the number of KiB/s given in the
+	// `x-envoy-fault-throughput-response` request header. Requests without the header are not limited.
+	// +optional
+	FromHeader *FaultFromHeader `json:"fromHeader,omitempty"`
+
+	// Percentage of requests whose responses are rate limited.
+	// Defaults to 100 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultFromHeader indicates that a fault is controlled by request headers.
+type FaultFromHeader struct{}
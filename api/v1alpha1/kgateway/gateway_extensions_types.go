@@ -40,16 +40,17 @@ type NamedJWTProvider struct {
 }
 
 // GatewayExtensionSpec defines the desired state of GatewayExtension.
-// +kubebuilder:validation:ExactlyOneOf=extAuth;extProc;rateLimit;jwt;oauth2
+// +kubebuilder:validation:ExactlyOneOf=extAuth;extProc;rateLimit;jwt;oauth2;wasm
 // +kubebuilder:validation:XValidation:message="extAuth must be set when type is ExtAuth",rule="has(self.type) && self.type == 'ExtAuth' ? has(self.extAuth) : true"
 // +kubebuilder:validation:XValidation:message="extProc must be set when type is ExtProc",rule="has(self.type) && self.type == 'ExtProc' ? has(self.extProc) : true"
 // +kubebuilder:validation:XValidation:message="rateLimit must be set when type is RateLimit",rule="has(self.type) && self.type == 'RateLimit' ? has(self.rateLimit) : true"
 // +kubebuilder:validation:XValidation:message="JWT must be set when type is JWT",rule="has(self.type) && self.type == 'JWT' ? has(self.jwt) : true"
 // +kubebuilder:validation:XValidation:message="oauth2 must be set when type is OAuth2",rule="has(self.type) && self.type == 'OAuth2' ? has(self.oauth2) : true"
+// +kubebuilder:validation:XValidation:message="wasm must be set when type is Wasm",rule="has(self.type) && self.type == 'Wasm' ? has(self.wasm) : true"
 type GatewayExtensionSpec struct {
 	// Deprecated: Setting this field has no effect.
 	// Type indicates the type of the GatewayExtension to be used.
-	// +kubebuilder:validation:Enum=ExtAuth;ExtProc;RateLimit;JWT;OAuth2
+	// +kubebuilder:validation:Enum=ExtAuth;ExtProc;RateLimit;JWT;OAuth2;Wasm
 	// +optional
 	Type *GatewayExtensionType `json:"type,omitempty"`
 
@@ -72,6 +73,10 @@ type GatewayExtensionSpec struct {
 	// OAuth2 configuration for OAuth2 extension type.
 	// +optional
 	OAuth2 *OAuth2Provider `json:"oauth2,omitempty"`
+
+	// Wasm configuration for Wasm extension type.
+	// +optional
+	Wasm *WasmProvider `json:"wasm,omitempty"`
 }
 
 type JWT struct {
@@ -119,6 +124,8 @@ const (
 	GatewayExtensionTypeJWT GatewayExtensionType = "JWT"
 	// GatewayExtensionTypeOAuth2 is the type for OAuth2 extensions.
 	GatewayExtensionTypeOAuth2 GatewayExtensionType = "OAuth2"
+	// GatewayExtensionTypeWasm is the type for Wasm extensions.
+	GatewayExtensionTypeWasm GatewayExtensionType = "Wasm"
 )
 
 const HTTPDefaultTimeout = 2 * time.Second
@@ -226,10 +233,18 @@ type RetryBackoff struct {
 }
 
 // RateLimitProvider defines the configuration for a RateLimit service provider.
+// +kubebuilder:validation:ExactlyOneOf=grpcService;builtin
 type RateLimitProvider struct {
 	// GrpcService is the GRPC service that will handle the rate limiting.
-	// +required
-	GrpcService ExtGrpcService `json:"grpcService"`
+	// It is required unless the built-in rate limit service is used.
+	// +optional
+	GrpcService ExtGrpcService `json:"grpcService,omitzero"`
+
+	// Builtin uses the rate limit service built into the kgateway controller instead of an external service.
+	// The limits are defined by the `limit` of the descriptors of the TrafficPolicies using the extension.
+	// The built-in service must be enabled on the controller.
+	// +optional
+	Builtin *BuiltinRateLimitService `json:"builtin,omitempty"`
 
 	// Domain identifies a rate limiting configuration for the rate limit service.
 	// All rate limit requests must specify a domain, which enables the configuration
@@ -260,6 +275,10 @@ type RateLimitProvider struct {
 	XRateLimitHeaders XRateLimitHeadersStandard `json:"xRateLimitHeaders,omitempty"`
 }
 
+// BuiltinRateLimitService configures the use of the rate limit service built into the kgateway controller.
+// The counters are kept in memory by each controller replica, unless the controller is configured with a Redis store.
+type BuiltinRateLimitService struct{}
+
 // XRateLimitHeadersStandard controls how XRateLimit headers will emitted.
 type XRateLimitHeadersStandard string
 
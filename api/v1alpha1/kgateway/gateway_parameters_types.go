@@ -118,6 +118,11 @@ type KubernetesProxyConfig struct {
 	// +optional
 	Stats *StatsConfig `json:"stats,omitempty"`
 
+	// Configuration for the AWS integration.
+	//
+	// +optional
+	Aws *AwsIntegration `json:"aws,omitempty"`
+
 	// Obsolete: This field is no longer used. Agentgateway configuration is now
 	// determined automatically based on the GatewayClass controllerName
 	// (agentgateway.dev/agentgateway). Use the AgentgatewayParameters API to
@@ -197,6 +202,13 @@ func (in *KubernetesProxyConfig) GetStats() *StatsConfig {
 	return in.Stats
 }
 
+func (in *KubernetesProxyConfig) GetAws() *AwsIntegration {
+	if in == nil {
+		return nil
+	}
+	return in.Aws
+}
+
 func (in *KubernetesProxyConfig) GetAgentgateway() *Agentgateway {
 	if in == nil {
 		return nil
@@ -581,6 +593,100 @@ func (in *IstioContainer) GetIstioMetaClusterId() *string {
 	return in.IstioMetaClusterId
 }
 
+// AwsIntegration configures the proxy pods to obtain AWS credentials from a
+// projected service account token, so that AWS Backends don't need long-lived
+// access keys. This is only needed when the EKS webhooks don't already inject
+// the token, e.g. on self-managed clusters.
+type AwsIntegration struct {
+	// WebIdentity projects a service account token for AssumeRoleWithWebIdentity
+	// (IRSA) into the proxy container at /var/run/secrets/eks.amazonaws.com/serviceaccount/token,
+	// and sets the AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN environment variables.
+	//
+	// +optional
+	WebIdentity *AwsWebIdentityTokenProjection `json:"webIdentity,omitempty"`
+
+	// PodIdentity projects a service account token for EKS Pod Identity into the proxy
+	// container, and sets the AWS_CONTAINER_CREDENTIALS_FULL_URI and
+	// AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE environment variables.
+	//
+	// +optional
+	PodIdentity *AwsPodIdentityTokenProjection `json:"podIdentity,omitempty"`
+}
+
+func (in *AwsIntegration) GetWebIdentity() *AwsWebIdentityTokenProjection {
+	if in == nil {
+		return nil
+	}
+	return in.WebIdentity
+}
+
+func (in *AwsIntegration) GetPodIdentity() *AwsPodIdentityTokenProjection {
+	if in == nil {
+		return nil
+	}
+	return in.PodIdentity
+}
+
+// AwsWebIdentityTokenProjection configures the projected web identity token.
+type AwsWebIdentityTokenProjection struct {
+	// The ARN of the IAM role to assume, set as the AWS_ROLE_ARN environment variable.
+	// This role is used by AWS Backends that don't specify explicit auth.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern="^arn:aws[a-z-]*:iam::[0-9]{12}:role/[A-Za-z0-9+=,.@_/-]+$"
+	// +kubebuilder:validation:MaxLength=2048
+	RoleArn *string `json:"roleArn,omitempty"`
+
+	// The audience of the token. Defaults to sts.amazonaws.com.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Audience *string `json:"audience,omitempty"`
+
+	// The requested lifetime of the token. Defaults to 86400 (24 hours).
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=600
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+func (in *AwsWebIdentityTokenProjection) GetRoleArn() *string {
+	if in == nil {
+		return nil
+	}
+	return in.RoleArn
+}
+
+func (in *AwsWebIdentityTokenProjection) GetAudience() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Audience
+}
+
+func (in *AwsWebIdentityTokenProjection) GetExpirationSeconds() *int64 {
+	if in == nil {
+		return nil
+	}
+	return in.ExpirationSeconds
+}
+
+// AwsPodIdentityTokenProjection configures the projected EKS Pod Identity token.
+type AwsPodIdentityTokenProjection struct {
+	// The requested lifetime of the token. Defaults to 86400 (24 hours).
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=600
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+func (in *AwsPodIdentityTokenProjection) GetExpirationSeconds() *int64 {
+	if in == nil {
+		return nil
+	}
+	return in.ExpirationSeconds
+}
+
 // Configuration for the stats server.
 type StatsConfig struct {
 	// Whether to expose metrics annotations and ports for scraping metrics.
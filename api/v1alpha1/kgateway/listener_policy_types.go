@@ -112,6 +112,265 @@ type ListenerConfig struct {
 	// that should map 1-to-1 with a given HTTP listener, such as the Envoy health check HTTP filter.
 	// +optional
 	HTTPSettings *HTTPSettings `json:"httpSettings,omitempty"`
+
+	// HTTP3 enables HTTP/3 (QUIC) for the HTTPS listeners handling traffic on the port.
+	// A UDP listener is added on the same port, sharing the certificates and filter chains of the
+	// HTTPS listeners, and responses sent over TCP advertise it to clients with the `alt-svc` header.
+	// The Gateway Service exposes the matching UDP port. Listeners without TLS termination are not affected.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http3
+	// +optional
+	HTTP3 *HTTP3Settings `json:"http3,omitempty"`
+
+	// TLS configures the listeners terminating TLS on the port, overriding the TLS settings of the
+	// Gateway listeners. Listeners without TLS termination are not affected.
+	// +optional
+	TLS *ListenerTLSConfig `json:"tls,omitempty"`
+
+	// Connections limits the number and the rate of the connections accepted on the port.
+	// The limits apply to each Envoy proxy replica.
+	// +optional
+	Connections *ConnectionLimits `json:"connections,omitempty"`
+}
+
+// ConnectionLimits limits the downstream connections of a listener.
+// Connections over the limits are closed before any data is read from them. Connections denied by the
+// networkAuthorization of a TrafficPolicy attached to a TCPRoute are not counted by the limits.
+// +kubebuilder:validation:AtLeastOneOf=maxConnections;rateLimit;perSourceRateLimit
+// +kubebuilder:validation:XValidation:rule="has(self.delay) ? has(self.maxConnections) : true",message="delay can only be set together with maxConnections"
+type ConnectionLimits struct {
+	// MaxConnections is the maximum number of active connections of each Gateway listener on the port.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/connection_limit_filter
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// Delay is the time to wait before closing connections over maxConnections, so that
+	// clients can't retry as fast as possible. If unset, the connections are closed immediately.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	Delay *metav1.Duration `json:"delay,omitempty"`
+
+	// RateLimit limits the rate at which new connections are accepted on the port. Each connection
+	// consumes a token of the bucket, which is shared by all clients and Gateway listeners of the port.
+	// Use perSourceRateLimit to limit each client separately.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/local_rate_limit_filter
+	// +optional
+	RateLimit *TokenBucket `json:"rateLimit,omitempty"`
+
+	// PerSourceRateLimit limits the rate at which each client IP address can open new connections on the port,
+	// across all Gateway listeners of the port. When the PROXY protocol is enabled, the address from the
+	// PROXY protocol header is used. Unlike the other limits, the connections are counted by the built-in
+	// rate limit service of the kgateway controller, which must be enabled. Connections are accepted when
+	// the service can't be reached. Each connection counts as one request of the limit.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/rate_limit_filter
+	// +optional
+	PerSourceRateLimit *RateLimitDescriptorLimit `json:"perSourceRateLimit,omitempty"`
+}
+
+// ListenerTLSConfig configures TLS for the listeners terminating TLS.
+// +kubebuilder:validation:AtLeastOneOf=clientValidation;forwardClientCert;parameters;alpnProtocols
+type ListenerTLSConfig struct {
+	// ClientValidation configures the validation of client certificates (mTLS).
+	// +optional
+	ClientValidation *ClientCertificateValidation `json:"clientValidation,omitempty"`
+
+	// ForwardClientCert configures how the details of the client certificate are forwarded to backends.
+	// +optional
+	ForwardClientCert *ForwardClientCert `json:"forwardClientCert,omitempty"`
+
+	// Parameters configures the TLS versions, cipher suites and ECDH curves offered to clients.
+	// Unset fields keep the settings of the Gateway listener.
+	// See the [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/tls/v3/common.proto#extensions-transport-sockets-tls-v3-tlsparameters)
+	// for more information on the meaning of these values.
+	// +optional
+	Parameters *TLSParameters `json:"parameters,omitempty"`
+
+	// AlpnProtocols sets the application protocols negotiated with clients, in order of preference.
+	// If unset, the protocols of the Gateway listener are kept.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=32
+	AlpnProtocols []string `json:"alpnProtocols,omitempty"`
+}
+
+// ClientCertificateValidationMode defines whether clients must present a certificate.
+// +kubebuilder:validation:Enum=Required;Optional
+type ClientCertificateValidationMode string
+
+const (
+	// ClientCertificateValidationModeRequired rejects connections without a valid client certificate.
+	ClientCertificateValidationModeRequired ClientCertificateValidationMode = "Required"
+	// ClientCertificateValidationModeOptional accepts connections without a client certificate.
+	// Certificates presented by clients must still be valid.
+	ClientCertificateValidationModeOptional ClientCertificateValidationMode = "Optional"
+)
+
+// ClientCertificateValidation configures the validation of client certificates.
+// +kubebuilder:validation:AtLeastOneOf=caCertificateRefs;certificateHashes;spkiHashes
+// +kubebuilder:validation:XValidation:rule="!has(self.subjectAltNames) || has(self.caCertificateRefs)",message="subjectAltNames requires caCertificateRefs"
+// +kubebuilder:validation:XValidation:rule="!has(self.crlRefs) || has(self.caCertificateRefs)",message="crlRefs requires caCertificateRefs"
+type ClientCertificateValidation struct {
+	// CACertificateRefs references the ConfigMaps or Secrets holding the PEM encoded CA certificates
+	// used to validate client certificates in the `ca.crt` key. References to other namespaces require
+	// a ReferenceGrant.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self.all(r, r.group == '' && (r.kind == 'ConfigMap' || r.kind == 'Secret'))",message="caCertificateRefs may only reference ConfigMap or Secret resources"
+	CACertificateRefs []gwv1.ObjectReference `json:"caCertificateRefs,omitempty"`
+
+	// CRLRefs references the ConfigMaps or Secrets holding PEM encoded certificate revocation lists
+	// in the `ca.crl` key. When set, a client certificate is rejected if it is revoked, or if the
+	// CRL of its issuer is not provided. References to other namespaces require a ReferenceGrant.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self.all(r, r.group == '' && (r.kind == 'ConfigMap' || r.kind == 'Secret'))",message="crlRefs may only reference ConfigMap or Secret resources"
+	CRLRefs []gwv1.ObjectReference `json:"crlRefs,omitempty"`
+
+	// SubjectAltNames restricts the accepted client certificates to those with one of the given
+	// Subject Alternative Names.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	SubjectAltNames []SubjectAltNameMatch `json:"subjectAltNames,omitempty"`
+
+	// CertificateHashes pins the accepted client certificates to the given hex encoded SHA-256
+	// hashes of the DER encoded certificate, optionally separated by colons.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Pattern=`^([0-9a-fA-F]{64}|([0-9a-fA-F]{2}:){31}[0-9a-fA-F]{2})$`
+	CertificateHashes []string `json:"certificateHashes,omitempty"`
+
+	// SPKIHashes pins the accepted client certificates to the given base64 encoded SHA-256
+	// hashes of the Subject Public Key Information.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9+/]{43}=$`
+	SPKIHashes []string `json:"spkiHashes,omitempty"`
+
+	// Mode defines whether clients must present a certificate. Defaults to Required.
+	// +optional
+	Mode *ClientCertificateValidationMode `json:"mode,omitempty"`
+}
+
+// SubjectAltNameType defines the type of a Subject Alternative Name.
+// +kubebuilder:validation:Enum=DNS;URI;Email;IPAddress
+type SubjectAltNameType string
+
+const (
+	SubjectAltNameTypeDNS       SubjectAltNameType = "DNS"
+	SubjectAltNameTypeURI       SubjectAltNameType = "URI"
+	SubjectAltNameTypeEmail     SubjectAltNameType = "Email"
+	SubjectAltNameTypeIPAddress SubjectAltNameType = "IPAddress"
+)
+
+// SubjectAltNameMatch matches a Subject Alternative Name of a certificate.
+type SubjectAltNameMatch struct {
+	// Type is the type of the Subject Alternative Name.
+	// +required
+	Type SubjectAltNameType `json:"type"`
+
+	// Value is the exact value of the Subject Alternative Name.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Value string `json:"value"`
+}
+
+// ForwardClientCertMode defines how the `x-forwarded-client-cert` (XFCC) header is handled.
+// +kubebuilder:validation:Enum=Sanitize;ForwardOnly;AppendForward;SanitizeSet;AlwaysForwardOnly
+type ForwardClientCertMode string
+
+const (
+	// ForwardClientCertModeSanitize removes the XFCC header from requests.
+	ForwardClientCertModeSanitize ForwardClientCertMode = "Sanitize"
+	// ForwardClientCertModeForwardOnly forwards the XFCC header of requests received over mTLS.
+	ForwardClientCertModeForwardOnly ForwardClientCertMode = "ForwardOnly"
+	// ForwardClientCertModeAppendForward appends the details of the client certificate to the XFCC
+	// header of requests received over mTLS.
+	ForwardClientCertModeAppendForward ForwardClientCertMode = "AppendForward"
+	// ForwardClientCertModeSanitizeSet replaces the XFCC header of requests received over mTLS with
+	// the details of the client certificate.
+	ForwardClientCertModeSanitizeSet ForwardClientCertMode = "SanitizeSet"
+	// ForwardClientCertModeAlwaysForwardOnly always forwards the XFCC header of requests.
+	ForwardClientCertModeAlwaysForwardOnly ForwardClientCertMode = "AlwaysForwardOnly"
+)
+
+// ForwardClientCertDetail is a detail of the client certificate added to the XFCC header.
+// +kubebuilder:validation:Enum=Subject;Cert;Chain;DNS;URI
+type ForwardClientCertDetail string
+
+const (
+	ForwardClientCertDetailSubject ForwardClientCertDetail = "Subject"
+	ForwardClientCertDetailCert    ForwardClientCertDetail = "Cert"
+	ForwardClientCertDetailChain   ForwardClientCertDetail = "Chain"
+	ForwardClientCertDetailDNS     ForwardClientCertDetail = "DNS"
+	ForwardClientCertDetailURI     ForwardClientCertDetail = "URI"
+)
+
+// ClientCertField is a field of the client certificate.
+// +kubebuilder:validation:Enum=Subject;Issuer;URISAN;DNSSAN;Serial;FingerprintSHA256;Certificate
+type ClientCertField string
+
+const (
+	ClientCertFieldSubject           ClientCertField = "Subject"
+	ClientCertFieldIssuer            ClientCertField = "Issuer"
+	ClientCertFieldURISAN            ClientCertField = "URISAN"
+	ClientCertFieldDNSSAN            ClientCertField = "DNSSAN"
+	ClientCertFieldSerial            ClientCertField = "Serial"
+	ClientCertFieldFingerprintSHA256 ClientCertField = "FingerprintSHA256"
+	// ClientCertFieldCertificate is the URL encoded PEM client certificate.
+	ClientCertFieldCertificate ClientCertField = "Certificate"
+)
+
+// ForwardClientCert configures how the details of the client certificate are forwarded to backends.
+// +kubebuilder:validation:AtLeastOneOf=mode;headers
+// +kubebuilder:validation:XValidation:rule="!has(self.details) || (has(self.mode) && (self.mode == 'AppendForward' || self.mode == 'SanitizeSet'))",message="details requires the AppendForward or SanitizeSet mode"
+type ForwardClientCert struct {
+	// Mode defines how the `x-forwarded-client-cert` (XFCC) header is handled. When unset,
+	// the XFCC header is removed from requests.
+	// See the [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#x-forwarded-client-cert)
+	// for more information.
+	// +optional
+	Mode *ForwardClientCertMode `json:"mode,omitempty"`
+
+	// Details are the details of the client certificate added to the XFCC header, in
+	// addition to the hash. Only used with the AppendForward and SanitizeSet modes.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=5
+	Details []ForwardClientCertDetail `json:"details,omitempty"`
+
+	// Headers sets request headers to fields of the client certificate. Values sent by
+	// clients are always replaced, and the headers are removed when the client did not
+	// present a certificate.
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Headers []ClientCertHeader `json:"headers,omitempty"`
+}
+
+// ClientCertHeader sets a request header to a field of the client certificate.
+type ClientCertHeader struct {
+	// Name is the name of the header.
+	// +required
+	Name gwv1.HTTPHeaderName `json:"name"`
+
+	// Field is the field of the client certificate.
+	// +required
+	Field ClientCertField `json:"field"`
+}
+
+// HTTP3Settings configures HTTP/3 (QUIC) for HTTPS listeners.
+// The presence of this configuration enables HTTP/3.
+type HTTP3Settings struct {
+	// AltSvcMaxAge is the duration for which clients may remember that HTTP/3 is available,
+	// advertised as the `ma` parameter of the `alt-svc` header. Defaults to 24h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="altSvcMaxAge must be at least 1s"
+	AltSvcMaxAge *metav1.Duration `json:"altSvcMaxAge,omitempty"`
 }
 
 // ProxyProtocolConfig configures the PROXY protocol listener filter.
@@ -194,6 +453,13 @@ type HTTPSettings struct {
 	// sure it did not come from the client.
 	// +optional
 	EarlyRequestHeaderModifier *gwv1.HTTPHeaderFilter `json:"earlyRequestHeaderModifier,omitempty"`
+
+	// GeoIP configures the Envoy geoip filter, which looks up the geolocation of the client IP address
+	// and writes it to request headers. The client IP address is the one determined by the connection manager,
+	// so it honors UseRemoteAddress and XffNumTrustedHops.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/geoip_filter
+	// +optional
+	GeoIP *GeoIP `json:"geoIP,omitempty"`
 }
 
 // AccessLog represents the top-level access log configuration.
@@ -789,3 +1055,59 @@ type EnvoyHealthCheck struct {
 	// +required
 	Path string `json:"path"`
 }
+
+// GeoIP configures the geolocation lookup of the client IP address.
+// +kubebuilder:validation:XValidation:rule="!has(self.headers.country) && !has(self.headers.city) && !has(self.headers.region) || has(self.maxMind.cityDbPath)",message="maxMind.cityDbPath is required to write the country, city or region headers"
+// +kubebuilder:validation:XValidation:rule="!has(self.headers.asn) || has(self.maxMind.asnDbPath)",message="maxMind.asnDbPath is required to write the asn header"
+type GeoIP struct {
+	// MaxMind configures the MaxMind databases used for the lookup.
+	// +required
+	MaxMind MaxMindGeoIPProvider `json:"maxMind"`
+
+	// Headers configures the request headers the geolocation is written to.
+	// Headers with these names sent by clients are removed before the lookup, so the values cannot
+	// be spoofed, even when the lookup of a value fails.
+	// +required
+	Headers GeoIPHeaders `json:"headers"`
+}
+
+// MaxMindGeoIPProvider configures the MaxMind database files.
+// The files must be present in the proxy container, e.g. mounted with the `extraVolumes` and
+// `extraVolumeMounts` settings of the GatewayParameters.
+// +kubebuilder:validation:AtLeastOneOf=cityDbPath;asnDbPath
+type MaxMindGeoIPProvider struct {
+	// CityDBPath is the path of the MaxMind City database (GeoIP2-City or GeoLite2-City),
+	// used to look up the country, city and region.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*\.mmdb$`
+	// +kubebuilder:validation:MaxLength=1024
+	CityDBPath *string `json:"cityDbPath,omitempty"`
+
+	// ASNDBPath is the path of the MaxMind ASN database (GeoIP2-ASN or GeoLite2-ASN),
+	// used to look up the autonomous system number.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*\.mmdb$`
+	// +kubebuilder:validation:MaxLength=1024
+	ASNDBPath *string `json:"asnDbPath,omitempty"`
+}
+
+// GeoIPHeaders configures the names of the request headers the geolocation is written to.
+// A header is only written if its name is set and the lookup of its value succeeds.
+// +kubebuilder:validation:AtLeastOneOf=country;city;region;asn
+type GeoIPHeaders struct {
+	// Country is the name of the header for the ISO 3166-1 country code, e.g. `x-geo-country`.
+	// +optional
+	Country *gwv1.HTTPHeaderName `json:"country,omitempty"`
+
+	// City is the name of the header for the city name, e.g. `x-geo-city`.
+	// +optional
+	City *gwv1.HTTPHeaderName `json:"city,omitempty"`
+
+	// Region is the name of the header for the ISO 3166-2 subdivision code, e.g. `x-geo-region`.
+	// +optional
+	Region *gwv1.HTTPHeaderName `json:"region,omitempty"`
+
+	// ASN is the name of the header for the autonomous system number, e.g. `x-geo-asn`.
+	// +optional
+	ASN *gwv1.HTTPHeaderName `json:"asn,omitempty"`
+}
@@ -0,0 +1,192 @@
+package kgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// AdaptiveConcurrency dynamically limits the number of concurrent requests forwarded to the backends,
+// and rejects the requests exceeding the limit. The limit is calculated by a gradient controller that
+// periodically measures the minimum round-trip time (minRTT) of the backends under low concurrency, and
+// adjusts the limit by comparing the latency of the sampled requests to the minRTT.
+// The limit applies to each Envoy proxy replica and is shared by the routes of a listener that have
+// the same adaptive concurrency configuration.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/adaptive_concurrency_filter
+//
+// +kubebuilder:validation:ExactlyOneOf=gradientController;disable
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.concurrencyLimitExceededStatus) : true",message="disable cannot be set together with other adaptiveConcurrency fields"
+type AdaptiveConcurrency struct {
+	// GradientController configures the calculation of the concurrency limit.
+	// +optional
+	GradientController *GradientController `json:"gradientController,omitempty"`
+
+	// ConcurrencyLimitExceededStatus is the HTTP status code returned for the requests
+	// exceeding the concurrency limit. Defaults to 503.
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	ConcurrencyLimitExceededStatus *int32 `json:"concurrencyLimitExceededStatus,omitempty"`
+
+	// Disable adaptive concurrency.
+	// Can be used to disable adaptive concurrency policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// GradientController configures the calculation of the adaptive concurrency limit.
+type GradientController struct {
+	// SampleAggregatePercentile is the percentile of the latencies of the sampled requests
+	// that is compared to the minRTT. Defaults to 50.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SampleAggregatePercentile *int32 `json:"sampleAggregatePercentile,omitempty"`
+
+	// MaxConcurrencyLimit is the maximum value of the concurrency limit. Defaults to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrencyLimit *int32 `json:"maxConcurrencyLimit,omitempty"`
+
+	// ConcurrencyUpdateInterval is the sampling window after which the concurrency limit is recalculated.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="concurrencyUpdateInterval must be at least 1ms"
+	ConcurrencyUpdateInterval metav1.Duration `json:"concurrencyUpdateInterval"`
+
+	// MinRTT configures the measurement of the minimum round-trip time of the backends.
+	// +required
+	MinRTT MinRTTCalculation `json:"minRTT"`
+}
+
+// MinRTTCalculation configures the measurement of the minimum round-trip time (minRTT) of the backends.
+//
+// +kubebuilder:validation:ExactlyOneOf=interval;fixedValue
+type MinRTTCalculation struct {
+	// Interval is the time between two measurements of the minRTT. During a measurement, the
+	// concurrency limit is lowered to MinConcurrency. Must be longer than the concurrencyUpdateInterval
+	// of the gradient controller.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="interval must be at least 1ms"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// FixedValue is a fixed minRTT used instead of measuring it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="fixedValue must be at least 1ms"
+	FixedValue *metav1.Duration `json:"fixedValue,omitempty"`
+
+	// RequestCount is the number of requests sampled to measure the minRTT. Defaults to 50.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RequestCount *int32 `json:"requestCount,omitempty"`
+
+	// Jitter is the maximum random delay added to the interval between two measurements, as a
+	// percentage of the interval, so that the proxy replicas do not measure at the same time. Defaults to 15.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Jitter *int32 `json:"jitter,omitempty"`
+
+	// MinConcurrency is the concurrency limit used while measuring the minRTT. Defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinConcurrency *int32 `json:"minConcurrency,omitempty"`
+
+	// Buffer is the percentage of the minRTT added to it to tolerate the natural variation
+	// of the latency. Defaults to 25.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Buffer *int32 `json:"buffer,omitempty"`
+}
+
+// AdmissionControl probabilistically rejects requests when the success rate of the backends over
+// the sampling window drops below the success rate threshold. The rejection probability increases as
+// the success rate decreases.
+// The success rate applies to each Envoy proxy replica and is shared by the routes of a listener that
+// have the same admission control configuration.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/admission_control_filter
+//
+// +kubebuilder:validation:ExactlyOneOf=successCriteria;disable
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.samplingWindow) && !has(self.aggression) && !has(self.successRateThreshold) && !has(self.rpsThreshold) && !has(self.maxRejectionProbability) : true",message="disable cannot be set together with other admissionControl fields"
+type AdmissionControl struct {
+	// SuccessCriteria defines the responses that are successful.
+	// +optional
+	SuccessCriteria *AdmissionControlSuccessCriteria `json:"successCriteria,omitempty"`
+
+	// SamplingWindow is the sliding time window over which the success rate is calculated. Defaults to 30s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="samplingWindow must be at least 1s"
+	SamplingWindow *metav1.Duration `json:"samplingWindow,omitempty"`
+
+	// Aggression controls how quickly the rejection probability increases as the success rate
+	// decreases. Values greater than 1.0 reject requests more aggressively. Must be at least 1.0. Defaults to 1.0.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="(self.matches('^(?:[0-9]+(?:\\\\.[0-9]*)?|\\\\.[0-9]+)$') && double(self) >= 1.0)",message="aggression must be a string representing a number of at least 1.0"
+	Aggression *string `json:"aggression,omitempty"`
+
+	// SuccessRateThreshold is the success rate percentage below which requests start being rejected.
+	// Defaults to 95.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SuccessRateThreshold *int32 `json:"successRateThreshold,omitempty"`
+
+	// RPSThreshold is the number of requests per second below which requests are never rejected.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RPSThreshold *int32 `json:"rpsThreshold,omitempty"`
+
+	// MaxRejectionProbability is the maximum percentage of requests that are rejected. Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxRejectionProbability *int32 `json:"maxRejectionProbability,omitempty"`
+
+	// Disable admission control.
+	// Can be used to disable admission control policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// AdmissionControlSuccessCriteria defines the responses that are successful.
+// By default, HTTP responses are successful if their status code is lower than 500, and gRPC
+// responses are successful unless their status is one of Aborted, DataLoss, DeadlineExceeded,
+// Internal, ResourceExhausted or Unavailable.
+type AdmissionControlSuccessCriteria struct {
+	// HTTP is the list of HTTP status code ranges of the successful HTTP responses.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	HTTP []HTTPStatusRange `json:"http,omitempty"`
+
+	// GRPC is the list of gRPC status codes of the successful gRPC responses.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=17
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=16
+	// +listType=set
+	GRPC []int32 `json:"grpc,omitempty"`
+}
+
+// HTTPStatusRange is a range of HTTP status codes.
+//
+// +kubebuilder:validation:XValidation:rule="self.start < self.end",message="start must be lower than end"
+type HTTPStatusRange struct {
+	// Start is the first status code of the range, inclusive.
+	// +required
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Start int32 `json:"start"`
+
+	// End is the end of the range, exclusive.
+	// +required
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=600
+	End int32 `json:"end"`
+}
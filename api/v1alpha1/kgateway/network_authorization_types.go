@@ -0,0 +1,59 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// NetworkAuthorization defines connection-level access control for TCP and TLS traffic.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/rbac_filter
+type NetworkAuthorization struct {
+	// Rules specifies the connections the action applies to.
+	// A connection matches the policy when it matches **any** of the rules.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []NetworkAuthorizationRule `json:"rules"`
+
+	// Action defines whether matching connections are allowed or denied.
+	// When the action is Allow, connections that don't match any rule are denied.
+	// If unspecified, the default is "Allow".
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:default=Allow
+	// +optional
+	Action shared.AuthorizationPolicyAction `json:"action,omitempty"`
+}
+
+// NetworkAuthorizationRule matches connections on the client address, the requested server name
+// and the client certificate. A connection matches the rule when it matches **all** of the set fields,
+// and a field matches when **any** of its values matches.
+// +kubebuilder:validation:AtLeastOneOf=sourceCIDRs;serverNames;principals
+type NetworkAuthorizationRule struct {
+	// SourceCIDRs matches the IP address of the client, e.g. `10.0.0.0/8` or `2001:db8::/32`.
+	// When the PROXY protocol is enabled on the listener, the address from the PROXY protocol header is used.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=43
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// ServerNames matches the server name indication (SNI) sent by the client in the TLS handshake.
+	// A name prefixed with `*.` matches all of its subdomains.
+	// Connections without SNI, e.g. plain TCP connections, don't match.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ServerNames []gwv1.Hostname `json:"serverNames,omitempty"`
+
+	// Principals matches the identity of the validated client certificate: its first URI SAN,
+	// e.g. a SPIFFE ID, or its first DNS SAN if it has no URI SAN, or its subject otherwise.
+	// Only connections with a client certificate validated by the listener, e.g. with the
+	// `tls.clientValidation` settings of a ListenerPolicy, match.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=1024
+	Principals []string `json:"principals,omitempty"`
+}
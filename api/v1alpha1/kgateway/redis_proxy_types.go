@@ -0,0 +1,101 @@
+package kgateway
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// RedisProxy proxies the connections of a TCPRoute as Redis connections, instead of forwarding them as
+// opaque TCP streams. Each command is routed on its key, and the commands of a client connection are
+// spread over pooled connections to the backends.
+// The TCPRoute must have a single backendRef, which receives the commands whose key doesn't match any prefix route.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/redis_proxy_filter
+type RedisProxy struct {
+	// OperationTimeout is the time to wait for the response to a command, after which the command
+	// fails with an error. For commands with multiple keys, the timeout applies to each key.
+	// The timeout applies to all commands, since Envoy doesn't support timeouts per command, so it
+	// must be longer than the slowest command, e.g. blocking commands such as BLPOP.
+	// If unspecified, the default is 1s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="operationTimeout must be at least 1ms"
+	OperationTimeout *metav1.Duration `json:"operationTimeout,omitempty"`
+
+	// CommandStats enables the stats of each Redis command, such as the number of calls and their latency.
+	// +optional
+	CommandStats *bool `json:"commandStats,omitempty"`
+
+	// ReadPolicy selects the nodes that receive the read-only commands. It only applies to backends that
+	// are Redis clusters, i.e. whose nodes are discovered with the CLUSTER SLOTS command.
+	// If unspecified, the default is Primary.
+	// +optional
+	ReadPolicy *RedisReadPolicy `json:"readPolicy,omitempty"`
+
+	// ReadBackendRef references the backend that receives the read-only commands whose key doesn't
+	// match any prefix route, e.g. the replicas of the backend of the TCPRoute.
+	// +optional
+	ReadBackendRef *gwv1.BackendObjectReference `json:"readBackendRef,omitempty"`
+
+	// PrefixRoutes route the commands whose key starts with a prefix to other backends.
+	// The longest matching prefix is used.
+	// +optional
+	// +listType=map
+	// +listMapKey=prefix
+	// +kubebuilder:validation:MaxItems=64
+	PrefixRoutes []RedisPrefixRoute `json:"prefixRoutes,omitempty"`
+
+	// DownstreamAuth requires the clients to authenticate with the AUTH command before sending other commands.
+	// +optional
+	DownstreamAuth *RedisDownstreamAuth `json:"downstreamAuth,omitempty"`
+}
+
+// RedisReadPolicy selects the nodes of a Redis cluster that receive the read-only commands.
+// +kubebuilder:validation:Enum=Primary;PreferPrimary;Replica;PreferReplica;Any
+type RedisReadPolicy string
+
+const (
+	// RedisReadPolicyPrimary sends the read-only commands to the primary nodes.
+	RedisReadPolicyPrimary RedisReadPolicy = "Primary"
+	// RedisReadPolicyPreferPrimary sends the read-only commands to the primary nodes, and to the
+	// replica nodes if the primary nodes are unhealthy.
+	RedisReadPolicyPreferPrimary RedisReadPolicy = "PreferPrimary"
+	// RedisReadPolicyReplica sends the read-only commands to the replica nodes.
+	RedisReadPolicyReplica RedisReadPolicy = "Replica"
+	// RedisReadPolicyPreferReplica sends the read-only commands to the replica nodes, and to the
+	// primary nodes if the replica nodes are unhealthy.
+	RedisReadPolicyPreferReplica RedisReadPolicy = "PreferReplica"
+	// RedisReadPolicyAny sends the read-only commands to any healthy node.
+	RedisReadPolicyAny RedisReadPolicy = "Any"
+)
+
+// RedisPrefixRoute routes the commands whose key starts with a prefix to a backend.
+type RedisPrefixRoute struct {
+	// Prefix is the prefix of the keys routed to the backend.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Prefix string `json:"prefix"`
+
+	// RemovePrefix removes the prefix from the keys before the commands are sent to the backend.
+	// +optional
+	RemovePrefix *bool `json:"removePrefix,omitempty"`
+
+	// BackendRef references the backend that receives the commands.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// ReadBackendRef references the backend that receives the read-only commands, e.g. the replicas of the backend.
+	// If unspecified, all the commands are sent to the backend referenced by backendRef.
+	// +optional
+	ReadBackendRef *gwv1.BackendObjectReference `json:"readBackendRef,omitempty"`
+}
+
+// RedisDownstreamAuth specifies the credentials the clients must authenticate with.
+type RedisDownstreamAuth struct {
+	// SecretRef references a Secret in the namespace of the policy that contains the password
+	// in the key 'password', and optionally the username in the key 'username'.
+	// Without a username, the clients authenticate with the password only, e.g. `AUTH <password>`.
+	// +required
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
@@ -13,6 +13,7 @@ type RetryOnCondition string
 // Retry defines the retry policy
 //
 // +kubebuilder:validation:XValidation:rule="has(self.retryOn) || has(self.statusCodes)",message="retryOn or statusCodes must be set."
+// +kubebuilder:validation:XValidation:rule="!has(self.hedgeOnPerTryTimeout) || !self.hedgeOnPerTryTimeout || has(self.perTryTimeout)",message="perTryTimeout must be set when hedgeOnPerTryTimeout is enabled."
 type Retry struct {
 	// RetryOn specifies the conditions under which a retry should be attempted.
 	// +optional
@@ -58,4 +59,39 @@ type Retry struct {
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
 	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="retry.backoffBaseInterval must be at least 1ms."
 	BackoffBaseInterval *metav1.Duration `json:"backoffBaseInterval,omitempty"`
+
+	// RetriableRequestHeaders restricts retries to the requests matching at least one of these headers.
+	// E.g., it can be used to only retry the requests carrying an idempotency key header.
+	// +optional
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	RetriableRequestHeaders []gwv1.HTTPHeaderMatch `json:"retriableRequestHeaders,omitempty"`
+
+	// HostSelection configures retries to be sent to an upstream host that was not
+	// selected by the previous attempts of the request.
+	// +optional
+	HostSelection *RetryHostSelection `json:"hostSelection,omitempty"`
+
+	// HedgeOnPerTryTimeout sends a hedged request to another upstream host when the per-try timeout
+	// elapses, instead of cancelling the attempt in flight. The first response received is returned
+	// to the client and the other attempts are cancelled.
+	// Hedged requests count as retries, so PerTryTimeout must be set and Attempts bounds the number of
+	// hedged requests.
+	// To limit the load retries put on the upstream hosts, configure a retry budget on the backend using
+	// the circuitBreakers.retryBudget field of BackendConfigPolicy.
+	// +optional
+	HedgeOnPerTryTimeout *bool `json:"hedgeOnPerTryTimeout,omitempty"`
+}
+
+// RetryHostSelection configures the selection of the upstream host of retries.
+type RetryHostSelection struct {
+	// MaxAttempts is the maximum number of times the host selection is reattempted to find a host
+	// that was not selected by the previous attempts, after which the last selected host is used.
+	// Defaults to 1 if not set.
+	// +optional
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
 }
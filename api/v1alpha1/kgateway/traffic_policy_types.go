@@ -41,6 +41,11 @@ type TrafficPolicyList struct {
 
 // TrafficPolicySpec defines the desired state of a traffic policy.
 // +kubebuilder:validation:XValidation:rule="!has(self.autoHostRewrite) || ((has(self.targetRefs) && self.targetRefs.all(r, r.kind == 'HTTPRoute')) || (has(self.targetSelectors) && self.targetSelectors.all(r, r.kind == 'HTTPRoute')))",message="autoHostRewrite can only be used when targeting HTTPRoute resources"
+// +kubebuilder:validation:XValidation:rule="!has(self.networkAuthorization) || ((has(self.targetRefs) && self.targetRefs.all(r, r.kind == 'TCPRoute' || r.kind == 'TLSRoute')) || (has(self.targetSelectors) && self.targetSelectors.all(r, r.kind == 'TCPRoute' || r.kind == 'TLSRoute')))",message="networkAuthorization can only be used when targeting TCPRoute or TLSRoute resources"
+// +kubebuilder:validation:XValidation:rule="!has(self.redisProxy) || ((has(self.targetRefs) && self.targetRefs.all(r, r.kind == 'TCPRoute')) || (has(self.targetSelectors) && self.targetSelectors.all(r, r.kind == 'TCPRoute')))",message="redisProxy can only be used when targeting TCPRoute resources"
+// +kubebuilder:validation:XValidation:rule="!has(self.udpSession) || ((has(self.targetRefs) && self.targetRefs.all(r, r.kind == 'UDPRoute')) || (has(self.targetSelectors) && self.targetSelectors.all(r, r.kind == 'UDPRoute')))",message="udpSession can only be used when targeting UDPRoute resources"
+// +kubebuilder:validation:XValidation:rule="!((has(self.targetRefs) && self.targetRefs.exists(r, r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind == 'UDPRoute')) || (has(self.targetSelectors) && self.targetSelectors.exists(r, r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind == 'UDPRoute'))) || !(has(self.transformation) || has(self.extProc) || has(self.extAuth) || has(self.rateLimit) || has(self.cors) || has(self.csrf) || has(self.headerModifiers) || has(self.autoHostRewrite) || has(self.buffer) || has(self.timeouts) || has(self.retry) || has(self.rbac) || has(self.jwt) || has(self.urlRewrite) || has(self.compression) || has(self.basicAuth) || has(self.apiKeyAuthentication) || has(self.oauth2) || has(self.faultInjection) || has(self.wasm) || has(self.cache) || has(self.bandwidthLimit) || has(self.errorPages) || has(self.adaptiveConcurrency) || has(self.admissionControl))",message="only networkAuthorization, redisProxy and udpSession can be used when targeting TCPRoute, TLSRoute or UDPRoute resources"
+// +kubebuilder:validation:XValidation:rule="!has(self.rateLimit) || !has(self.rateLimit.local) || !has(self.rateLimit.local.shareKey) || ((!has(self.targetRefs) || self.targetRefs.all(r, r.kind == 'HTTPRoute')) && (!has(self.targetSelectors) || self.targetSelectors.all(r, r.kind == 'HTTPRoute')))",message="rateLimit.local.shareKey can only be used when targeting HTTPRoute resources"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.timeouts) ? (has(self.retry.perTryTimeout) && has(self.timeouts.request) ? duration(self.retry.perTryTimeout) < duration(self.timeouts.request) : true) : true",message="retry.perTryTimeout must be less than timeouts.request"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.targetRefs) ? self.targetRefs.all(r, (r.kind == 'Gateway' ? has(r.sectionName) : true )) : true",message="targetRefs[].sectionName must be set when targeting Gateway resources with retry policy"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.targetSelectors) ? self.targetSelectors.all(r, (r.kind == 'Gateway' ? has(r.sectionName) : true )) : true",message="targetSelectors[].sectionName must be set when targeting Gateway resources with retry policy"
@@ -50,12 +55,12 @@ type TrafficPolicySpec struct {
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind.endsWith('ListenerSet')))",message="targetRefs may only reference Gateway, HTTPRoute, or ListenerSet resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind == 'UDPRoute' || r.kind.endsWith('ListenerSet')))",message="targetRefs may only reference Gateway, HTTPRoute, TCPRoute, TLSRoute, UDPRoute, or ListenerSet resources"
 	TargetRefs []shared.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs,omitempty"`
 
 	// TargetSelectors specifies the target selectors to select resources to attach the policy to.
 	// +optional
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind.endsWith('ListenerSet')))",message="targetSelectors may only reference Gateway, HTTPRoute, or ListenerSet resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind == 'UDPRoute' || r.kind.endsWith('ListenerSet')))",message="targetSelectors may only reference Gateway, HTTPRoute, TCPRoute, TLSRoute, UDPRoute, or ListenerSet resources"
 	TargetSelectors []shared.LocalPolicyTargetSelectorWithSectionName `json:"targetSelectors,omitempty"`
 
 	// Transformation is used to mutate and transform requests and responses
@@ -119,6 +124,21 @@ type TrafficPolicySpec struct {
 	// +optional
 	RBAC *shared.Authorization `json:"rbac,omitempty"`
 
+	// NetworkAuthorization specifies connection-level access control for TCPRoutes and TLSRoutes.
+	// Connections are authorized when they are accepted, before any data is forwarded to the backends.
+	// Only networkAuthorization and redisProxy can be used when targeting TCPRoutes and TLSRoutes.
+	// +optional
+	NetworkAuthorization *NetworkAuthorization `json:"networkAuthorization,omitempty"`
+
+	// RedisProxy proxies the connections of TCPRoutes with the Redis protocol.
+	// +optional
+	RedisProxy *RedisProxy `json:"redisProxy,omitempty"`
+
+	// UDPSession specifies the session settings of UDPRoutes.
+	// Only udpSession can be used when targeting UDPRoutes.
+	// +optional
+	UDPSession *UDPSession `json:"udpSession,omitempty"`
+
 	// JWT specifies the JWT authentication configuration for the policy.
 	// This defines the JWT providers and their configurations.
 	// +optional
@@ -150,6 +170,36 @@ type TrafficPolicySpec struct {
 	// malicious social engineering.
 	// +optional
 	OAuth2 *OAuth2Policy `json:"oauth2,omitempty"`
+
+	// FaultInjection configures the injection of delays, aborts and response rate limits
+	// into requests.
+	// +optional
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty"`
+
+	// Wasm configures the WebAssembly (Wasm) plugins that run for the targeted routes.
+	// +optional
+	Wasm *WasmPolicy `json:"wasm,omitempty"`
+
+	// Cache configures caching of upstream responses in the memory of the proxy.
+	// +optional
+	Cache *Cache `json:"cache,omitempty"`
+
+	// BandwidthLimit limits the bandwidth of request and response bodies.
+	// +optional
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty"`
+
+	// ErrorPages replaces the local replies of the proxy with custom error pages based on their status code and response flags.
+	// +optional
+	ErrorPages *ErrorPages `json:"errorPages,omitempty"`
+
+	// AdaptiveConcurrency dynamically limits the number of concurrent requests forwarded to the
+	// backends based on their latency.
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrency `json:"adaptiveConcurrency,omitempty"`
+
+	// AdmissionControl probabilistically rejects requests when the success rate of the backends drops.
+	// +optional
+	AdmissionControl *AdmissionControl `json:"admissionControl,omitempty"`
 }
 
 // URLRewrite specifies URL rewrite rules using regular expressions.
@@ -284,11 +334,114 @@ type RateLimit struct {
 
 // LocalRateLimitPolicy represents a policy for local rate limiting.
 // It defines the configuration for rate limiting using a token bucket mechanism.
+//
+// The token buckets are shared by all the routes the policy is applied to when the policy
+// targets a Gateway or a listener, and are unique to each route when the policy targets a route
+// unless a ShareKey is set.
+// An empty policy disables local rate limiting for the targets.
 type LocalRateLimitPolicy struct {
 	// TokenBucket represents the configuration for a token bucket local rate-limiting mechanism.
 	// It defines the parameters for controlling the rate at which requests are allowed.
 	// +optional
 	TokenBucket *TokenBucket `json:"tokenBucket,omitempty"`
+
+	// Descriptors define additional token buckets for the requests matching their entries,
+	// e.g. to limit each client or tenant separately. A request consumes a token of every
+	// descriptor it matches, in addition to a token of the TokenBucket if set.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Descriptors []LocalRateLimitDescriptor `json:"descriptors,omitempty"`
+
+	// MaxDynamicDescriptors is the maximum number of token buckets kept per descriptor
+	// having entries without a value. The least recently used buckets are evicted when
+	// the limit is reached. Defaults to 20.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxDynamicDescriptors *int32 `json:"maxDynamicDescriptors,omitempty"`
+
+	// ShareKey shares the token buckets of the policy among all the routes of a listener whose
+	// policies have the same share key, instead of each route having its own token buckets.
+	// The policies using the same share key are expected to define the same rate limits, as only
+	// one of them is used for the listener. Can only be used when targeting routes.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	ShareKey *string `json:"shareKey,omitempty"`
+
+	// XRateLimitHeaders configures the standard version to use for the X-RateLimit headers added to responses.
+	// Disabled by default.
+	// +optional
+	// +kubebuilder:validation:Enum=Off;DraftVersion03
+	XRateLimitHeaders *XRateLimitHeadersStandard `json:"xRateLimitHeaders,omitempty"`
+}
+
+// LocalRateLimitDescriptor defines a token bucket for the requests matching all its entries.
+type LocalRateLimitDescriptor struct {
+	// Entries are the request attributes the descriptor matches.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Entries []LocalRateLimitDescriptorEntry `json:"entries"`
+
+	// TokenBucket is the token bucket of the descriptor. When an entry does not specify a value,
+	// a separate token bucket is used for each distinct value of the entry.
+	// +required
+	TokenBucket TokenBucket `json:"tokenBucket"`
+}
+
+// LocalRateLimitDescriptorEntryType defines the type of a local rate limit descriptor entry.
+// +kubebuilder:validation:Enum=Header;RemoteAddress;Path;JWTClaim;APIKeyClientID
+type LocalRateLimitDescriptorEntryType string
+
+const (
+	// LocalRateLimitDescriptorEntryTypeHeader uses the value of a request header.
+	LocalRateLimitDescriptorEntryTypeHeader LocalRateLimitDescriptorEntryType = "Header"
+
+	// LocalRateLimitDescriptorEntryTypeRemoteAddress uses the client's IP address.
+	LocalRateLimitDescriptorEntryTypeRemoteAddress LocalRateLimitDescriptorEntryType = "RemoteAddress"
+
+	// LocalRateLimitDescriptorEntryTypePath uses the request path.
+	LocalRateLimitDescriptorEntryTypePath LocalRateLimitDescriptorEntryType = "Path"
+
+	// LocalRateLimitDescriptorEntryTypeJWTClaim uses a claim of the JWT validated by the JWT authentication policy.
+	LocalRateLimitDescriptorEntryTypeJWTClaim LocalRateLimitDescriptorEntryType = "JWTClaim"
+
+	// LocalRateLimitDescriptorEntryTypeAPIKeyClientID uses the client ID of the API key validated by the
+	// API key authentication policy.
+	LocalRateLimitDescriptorEntryTypeAPIKeyClientID LocalRateLimitDescriptorEntryType = "APIKeyClientID"
+)
+
+// LocalRateLimitDescriptorEntry defines a single entry of a local rate limit descriptor.
+// Requests without a value for the entry, e.g. without the header, do not match the descriptor.
+//
+// +kubebuilder:validation:XValidation:message="header must be set if and only if type is Header or APIKeyClientID",rule="(self.type == 'Header' || self.type == 'APIKeyClientID') == has(self.header)"
+// +kubebuilder:validation:XValidation:message="jwtClaim must be set if and only if type is JWTClaim",rule="(self.type == 'JWTClaim') == has(self.jwtClaim)"
+type LocalRateLimitDescriptorEntry struct {
+	// Type specifies the request attribute of the entry.
+	// +required
+	Type LocalRateLimitDescriptorEntryType `json:"type"`
+
+	// Header is the request header of a Header entry. For an APIKeyClientID entry, it is the
+	// header the API key authentication policy forwards the client ID in, see `clientIdHeader`.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Header *string `json:"header,omitempty"`
+
+	// JWTClaim is the name of the top-level claim of a JWTClaim entry.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+
+	// Value restricts the entry to the requests with the given value.
+	// If unset, each distinct value gets its own token bucket.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Value *string `json:"value,omitempty"`
 }
 
 // TokenBucket defines the configuration for a token bucket rate-limiting mechanism.
@@ -340,6 +493,36 @@ type RateLimitDescriptor struct {
 	// +required
 	// +kubebuilder:validation:MinItems=1
 	Entries []RateLimitDescriptorEntry `json:"entries"`
+
+	// Limit is the limit applied to the requests matching this descriptor by the built-in rate limit service.
+	// It is required when the extension uses the built-in rate limit service, and ignored otherwise since
+	// external rate limit services define the limits in their own configuration.
+	// Each distinct combination of entry values is counted separately.
+	// +optional
+	Limit *RateLimitDescriptorLimit `json:"limit,omitempty"`
+}
+
+// RateLimitUnit is the time unit of a rate limit.
+// +kubebuilder:validation:Enum=Second;Minute;Hour;Day
+type RateLimitUnit string
+
+const (
+	RateLimitUnitSecond RateLimitUnit = "Second"
+	RateLimitUnitMinute RateLimitUnit = "Minute"
+	RateLimitUnitHour   RateLimitUnit = "Hour"
+	RateLimitUnitDay    RateLimitUnit = "Day"
+)
+
+// RateLimitDescriptorLimit defines the number of requests allowed per time unit.
+type RateLimitDescriptorLimit struct {
+	// Requests is the number of requests allowed per unit.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	Requests int32 `json:"requests"`
+
+	// Unit is the time unit of the limit.
+	// +required
+	Unit RateLimitUnit `json:"unit"`
 }
 
 // RateLimitDescriptorEntryType defines the type of a rate limit descriptor entry.
@@ -0,0 +1,36 @@
+package kgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UDPSessionAffinity selects the client sessions that are forwarded to the same backend endpoint.
+// +kubebuilder:validation:Enum=SourceIP
+type UDPSessionAffinity string
+
+const (
+	// UDPSessionAffinitySourceIP forwards all sessions of a client IP address to the same backend endpoint.
+	UDPSessionAffinitySourceIP UDPSessionAffinity = "SourceIP"
+)
+
+// UDPSession defines how the datagrams of UDPRoutes are grouped into sessions.
+// A session is identified by the address and port of the client, and all of its datagrams are forwarded
+// to the same backend endpoint until it is idle.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/udp_filters/udp_proxy
+// +kubebuilder:validation:AtLeastOneOf=idleTimeout;affinity
+type UDPSession struct {
+	// IdleTimeout is the time after which a session without any datagram in either direction is closed.
+	// If unspecified, the default is 60s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="idleTimeout must be at least 1ms"
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// Affinity forwards the sessions of the same client to the same backend endpoint, so that e.g. a client
+	// changing its source port keeps talking to the same server.
+	// The backendRefs of a UDPRoute are always selected based on a hash of the client IP address.
+	// With SourceIP, the endpoint of the backend is selected with the same hash, which requires the backend
+	// to use a hashing load balancer, i.e. `loadBalancer.ringHash` or `loadBalancer.maglev` in a BackendConfigPolicy.
+	// +optional
+	Affinity *UDPSessionAffinity `json:"affinity,omitempty"`
+}
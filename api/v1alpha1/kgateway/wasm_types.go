@@ -0,0 +1,196 @@
+package kgateway
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// WasmProvider configures a WebAssembly (Wasm) HTTP filter module.
+// Based on: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/wasm_filter
+type WasmProvider struct {
+	// Module is the source of the Wasm module.
+	// +required
+	Module WasmModule `json:"module"`
+
+	// RootID identifies the root context of the plugin within the module.
+	// Must be set if the module contains more than one plugin.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	RootID *string `json:"rootId,omitempty"`
+
+	// FailOpen determines if requests are allowed when the Wasm plugin fails to load or crashes.
+	// Defaults to false, meaning requests are rejected.
+	// +optional
+	// +kubebuilder:default=false
+	FailOpen bool `json:"failOpen,omitempty"`
+}
+
+// WasmModule defines where a Wasm module is loaded from.
+// +kubebuilder:validation:ExactlyOneOf=image;http;configMap
+type WasmModule struct {
+	// Image loads the module from an OCI image. The image is mounted into the proxy pods of the
+	// Gateways using the module as an image volume, which requires the Kubernetes ImageVolume feature.
+	// +optional
+	Image *WasmImageSource `json:"image,omitempty"`
+
+	// HTTP fetches the module from an HTTP server.
+	// +optional
+	HTTP *WasmHTTPSource `json:"http,omitempty"`
+
+	// ConfigMap loads the module from the binary data of a ConfigMap in the same namespace as the GatewayExtension.
+	// Note that ConfigMaps are limited to 1MiB in size.
+	// +optional
+	ConfigMap *WasmConfigMapSource `json:"configMap,omitempty"`
+}
+
+// WasmImageSource defines an OCI image containing a Wasm module.
+type WasmImageSource struct {
+	// Reference is the OCI image reference, e.g. `ghcr.io/example/plugin:v1`.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Reference string `json:"reference"`
+
+	// PullPolicy is the policy used to pull the image.
+	// Defaults to Always if the `:latest` tag is specified, IfNotPresent otherwise.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	PullPolicy *corev1.PullPolicy `json:"pullPolicy,omitempty"`
+
+	// Path is the path of the Wasm module within the image.
+	// Defaults to `plugin.wasm`.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('/') && !self.contains('..')",message="path must be relative and must not contain '..'"
+	Path *string `json:"path,omitempty"`
+}
+
+// WasmHTTPSource defines a Wasm module fetched over HTTP.
+type WasmHTTPSource struct {
+	// URL is the location of the Wasm module.
+	// +required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+
+	// BackendRef references the backend serving the Wasm module. The module is fetched through
+	// this backend's cluster.
+	// +required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// Sha256 is the hex-encoded SHA-256 checksum of the Wasm module.
+	// The module is rejected if the checksum does not match.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	Sha256 string `json:"sha256"`
+
+	// Timeout is the timeout for fetching the module. Defaults to 2 seconds.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid timeout value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="timeout must be at least 1ms."
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// WasmConfigMapSource defines a Wasm module stored in a ConfigMap.
+type WasmConfigMapSource struct {
+	// Name is the name of the ConfigMap.
+	// +required
+	Name gwv1.ObjectName `json:"name"`
+
+	// Key is the key in the ConfigMap's binaryData holding the module.
+	// Defaults to `plugin.wasm`.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key *string `json:"key,omitempty"`
+}
+
+// WasmPolicy configures the Wasm plugins that run for the policy targets.
+// +kubebuilder:validation:ExactlyOneOf=plugins;disable
+type WasmPolicy struct {
+	// Plugins is the list of Wasm plugins to run. Plugins in the same stage run in the order they are listed.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Plugins []WasmPlugin `json:"plugins,omitempty"`
+
+	// Disable all Wasm plugins.
+	// Can be used to disable Wasm policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// WasmPlugin references a Wasm GatewayExtension and configures it for the policy targets.
+type WasmPlugin struct {
+	// ExtensionRef references the Wasm GatewayExtension providing the module.
+	// +required
+	ExtensionRef shared.NamespacedObjectReference `json:"extensionRef"`
+
+	// Config is the plugin configuration. It is serialized to JSON and passed to the plugin
+	// when it is configured.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+
+	// Stage is the stage of the HTTP filter chain the plugin runs in.
+	// Defaults to the Accepted stage.
+	// +optional
+	Stage *FilterStage `json:"stage,omitempty"`
+}
+
+// FilterStage defines a position in the HTTP filter chain relative to a well-known stage.
+type FilterStage struct {
+	// Name of the well-known stage.
+	// +required
+	Name FilterStageName `json:"name"`
+
+	// Placement of the filter relative to the stage.
+	// Defaults to During.
+	// +optional
+	Placement *FilterStagePlacement `json:"placement,omitempty"`
+}
+
+// FilterStageName is the name of a well-known HTTP filter chain stage.
+// +kubebuilder:validation:Enum=Fault;Cors;Waf;AuthN;AuthZ;RateLimit;Accepted;OutAuth;Route
+type FilterStageName string
+
+const (
+	// FilterStageFault is the fault injection stage, the first stage of the filter chain.
+	FilterStageFault FilterStageName = "Fault"
+	// FilterStageCors is the CORS stage.
+	FilterStageCors FilterStageName = "Cors"
+	// FilterStageWaf is the web application firewall stage.
+	FilterStageWaf FilterStageName = "Waf"
+	// FilterStageAuthN is the authentication stage.
+	FilterStageAuthN FilterStageName = "AuthN"
+	// FilterStageAuthZ is the authorization stage.
+	FilterStageAuthZ FilterStageName = "AuthZ"
+	// FilterStageRateLimit is the rate limiting stage.
+	FilterStageRateLimit FilterStageName = "RateLimit"
+	// FilterStageAccepted is the stage where the request has passed all checks and will be forwarded upstream.
+	FilterStageAccepted FilterStageName = "Accepted"
+	// FilterStageOutAuth is the stage where auth for the upstream is added.
+	FilterStageOutAuth FilterStageName = "OutAuth"
+	// FilterStageRoute is the stage where the request is routed upstream, the last stage of the filter chain.
+	FilterStageRoute FilterStageName = "Route"
+)
+
+// FilterStagePlacement is the placement of a filter relative to a stage.
+// +kubebuilder:validation:Enum=Before;During;After
+type FilterStagePlacement string
+
+const (
+	// FilterStagePlacementBefore places the filter before the stage.
+	FilterStagePlacementBefore FilterStagePlacement = "Before"
+	// FilterStagePlacementDuring places the filter in the stage.
+	FilterStagePlacementDuring FilterStagePlacement = "During"
+	// FilterStagePlacementAfter places the filter after the stage.
+	FilterStagePlacementAfter FilterStagePlacement = "After"
+)
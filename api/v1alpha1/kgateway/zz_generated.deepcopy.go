@@ -8,6 +8,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -185,6 +186,111 @@ func (in *AccessLogGrpcService) DeepCopy() *AccessLogGrpcService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrency) DeepCopyInto(out *AdaptiveConcurrency) {
+	*out = *in
+	if in.GradientController != nil {
+		in, out := &in.GradientController, &out.GradientController
+		*out = new(GradientController)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConcurrencyLimitExceededStatus != nil {
+		in, out := &in.ConcurrencyLimitExceededStatus, &out.ConcurrencyLimitExceededStatus
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrency.
+func (in *AdaptiveConcurrency) DeepCopy() *AdaptiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControl) DeepCopyInto(out *AdmissionControl) {
+	*out = *in
+	if in.SuccessCriteria != nil {
+		in, out := &in.SuccessCriteria, &out.SuccessCriteria
+		*out = new(AdmissionControlSuccessCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamplingWindow != nil {
+		in, out := &in.SamplingWindow, &out.SamplingWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessRateThreshold != nil {
+		in, out := &in.SuccessRateThreshold, &out.SuccessRateThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RPSThreshold != nil {
+		in, out := &in.RPSThreshold, &out.RPSThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRejectionProbability != nil {
+		in, out := &in.MaxRejectionProbability, &out.MaxRejectionProbability
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControl.
+func (in *AdmissionControl) DeepCopy() *AdmissionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControlSuccessCriteria) DeepCopyInto(out *AdmissionControlSuccessCriteria) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = make([]HTTPStatusRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControlSuccessCriteria.
+func (in *AdmissionControlSuccessCriteria) DeepCopy() *AdmissionControlSuccessCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControlSuccessCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Agentgateway) DeepCopyInto(out *Agentgateway) {
 	*out = *in
@@ -336,6 +442,11 @@ func (in *AwsAuth) DeepCopyInto(out *AwsAuth) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.WebIdentity != nil {
+		in, out := &in.WebIdentity, &out.WebIdentity
+		*out = new(AwsWebIdentityAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsAuth.
@@ -352,6 +463,11 @@ func (in *AwsAuth) DeepCopy() *AwsAuth {
 func (in *AwsBackend) DeepCopyInto(out *AwsBackend) {
 	*out = *in
 	in.Lambda.DeepCopyInto(&out.Lambda)
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(AwsService)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Auth != nil {
 		in, out := &in.Auth, &out.Auth
 		*out = new(AwsAuth)
@@ -369,6 +485,31 @@ func (in *AwsBackend) DeepCopy() *AwsBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsIntegration) DeepCopyInto(out *AwsIntegration) {
+	*out = *in
+	if in.WebIdentity != nil {
+		in, out := &in.WebIdentity, &out.WebIdentity
+		*out = new(AwsWebIdentityTokenProjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodIdentity != nil {
+		in, out := &in.PodIdentity, &out.PodIdentity
+		*out = new(AwsPodIdentityTokenProjection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsIntegration.
+func (in *AwsIntegration) DeepCopy() *AwsIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsLambda) DeepCopyInto(out *AwsLambda) {
 	*out = *in
@@ -389,6 +530,106 @@ func (in *AwsLambda) DeepCopy() *AwsLambda {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsPodIdentityTokenProjection) DeepCopyInto(out *AwsPodIdentityTokenProjection) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsPodIdentityTokenProjection.
+func (in *AwsPodIdentityTokenProjection) DeepCopy() *AwsPodIdentityTokenProjection {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsPodIdentityTokenProjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsService) DeepCopyInto(out *AwsService) {
+	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.UnsignedPayload != nil {
+		in, out := &in.UnsignedPayload, &out.UnsignedPayload
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsService.
+func (in *AwsService) DeepCopy() *AwsService {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsWebIdentityAuth) DeepCopyInto(out *AwsWebIdentityAuth) {
+	*out = *in
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenPath != nil {
+		in, out := &in.TokenPath, &out.TokenPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsWebIdentityAuth.
+func (in *AwsWebIdentityAuth) DeepCopy() *AwsWebIdentityAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsWebIdentityAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsWebIdentityTokenProjection) DeepCopyInto(out *AwsWebIdentityTokenProjection) {
+	*out = *in
+	if in.RoleArn != nil {
+		in, out := &in.RoleArn, &out.RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsWebIdentityTokenProjection.
+func (in *AwsWebIdentityTokenProjection) DeepCopy() *AwsWebIdentityTokenProjection {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsWebIdentityTokenProjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backend) DeepCopyInto(out *Backend) {
 	*out = *in
@@ -545,6 +786,21 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(CircuitBreakers)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(UpstreamProxyProtocol)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPConnectProxy != nil {
+		in, out := &in.HTTPConnectProxy, &out.HTTPConnectProxy
+		*out = new(HTTPConnectProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dns != nil {
+		in, out := &in.Dns, &out.Dns
+		*out = new(DnsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendConfigPolicySpec.
@@ -607,6 +863,16 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(DynamicForwardProxyBackend)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(FailoverBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DnsSrv != nil {
+		in, out := &in.DnsSrv, &out.DnsSrv
+		*out = new(DnsSrvBackend)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -662,6 +928,46 @@ func (in *BackoffStrategy) DeepCopy() *BackoffStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.RequestLimitKiBps != nil {
+		in, out := &in.RequestLimitKiBps, &out.RequestLimitKiBps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResponseLimitKiBps != nil {
+		in, out := &in.ResponseLimitKiBps, &out.ResponseLimitKiBps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FillInterval != nil {
+		in, out := &in.FillInterval, &out.FillInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RuntimeKey != nil {
+		in, out := &in.RuntimeKey, &out.RuntimeKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuthPolicy) DeepCopyInto(out *BasicAuthPolicy) {
 	*out = *in
@@ -737,6 +1043,21 @@ func (in *Buffer) DeepCopy() *Buffer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuiltinRateLimitService) DeepCopyInto(out *BuiltinRateLimitService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuiltinRateLimitService.
+func (in *BuiltinRateLimitService) DeepCopy() *BuiltinRateLimitService {
+	if in == nil {
+		return nil
+	}
+	out := new(BuiltinRateLimitService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CELFilter) DeepCopyInto(out *CELFilter) {
 	*out = *in
@@ -785,67 +1106,216 @@ func (in *CSRFPolicy) DeepCopy() *CSRFPolicy {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
+func (in *Cache) DeepCopyInto(out *Cache) {
 	*out = *in
-	if in.MaxConnections != nil {
-		in, out := &in.MaxConnections, &out.MaxConnections
-		*out = new(int32)
-		**out = **in
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]apisv1.HTTPHeaderName, len(*in))
+		copy(*out, *in)
 	}
-	if in.MaxPendingRequests != nil {
-		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
-		*out = new(int32)
-		**out = **in
+	if in.CacheKey != nil {
+		in, out := &in.CacheKey, &out.CacheKey
+		*out = new(CacheKey)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxRequests != nil {
-		in, out := &in.MaxRequests, &out.MaxRequests
-		*out = new(int32)
-		**out = **in
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.MaxRetries != nil {
-		in, out := &in.MaxRetries, &out.MaxRetries
-		*out = new(int32)
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakers.
-func (in *CircuitBreakers) DeepCopy() *CircuitBreakers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
 	if in == nil {
 		return nil
 	}
-	out := new(CircuitBreakers)
+	out := new(Cache)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonAccessLogGrpcService) DeepCopyInto(out *CommonAccessLogGrpcService) {
+func (in *CacheKey) DeepCopyInto(out *CacheKey) {
 	*out = *in
-	in.CommonGrpcService.DeepCopyInto(&out.CommonGrpcService)
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = new(CacheKeyQueryParameters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonAccessLogGrpcService.
-func (in *CommonAccessLogGrpcService) DeepCopy() *CommonAccessLogGrpcService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKey.
+func (in *CacheKey) DeepCopy() *CacheKey {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonAccessLogGrpcService)
+	out := new(CacheKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonGrpcService) DeepCopyInto(out *CommonGrpcService) {
+func (in *CacheKeyQueryParameters) DeepCopyInto(out *CacheKeyQueryParameters) {
 	*out = *in
-	in.BackendRef.DeepCopyInto(&out.BackendRef)
-	if in.Authority != nil {
-		in, out := &in.Authority, &out.Authority
-		*out = new(string)
-		**out = **in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.MaxReceiveMessageLength != nil {
-		in, out := &in.MaxReceiveMessageLength, &out.MaxReceiveMessageLength
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKeyQueryParameters.
+func (in *CacheKeyQueryParameters) DeepCopy() *CacheKeyQueryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKeyQueryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakers.
+func (in *CircuitBreakers) DeepCopy() *CircuitBreakers {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertHeader) DeepCopyInto(out *ClientCertHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertHeader.
+func (in *ClientCertHeader) DeepCopy() *ClientCertHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificateValidation) DeepCopyInto(out *ClientCertificateValidation) {
+	*out = *in
+	if in.CACertificateRefs != nil {
+		in, out := &in.CACertificateRefs, &out.CACertificateRefs
+		*out = make([]apisv1.ObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CRLRefs != nil {
+		in, out := &in.CRLRefs, &out.CRLRefs
+		*out = make([]apisv1.ObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]SubjectAltNameMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateHashes != nil {
+		in, out := &in.CertificateHashes, &out.CertificateHashes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SPKIHashes != nil {
+		in, out := &in.SPKIHashes, &out.SPKIHashes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ClientCertificateValidationMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificateValidation.
+func (in *ClientCertificateValidation) DeepCopy() *ClientCertificateValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificateValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonAccessLogGrpcService) DeepCopyInto(out *CommonAccessLogGrpcService) {
+	*out = *in
+	in.CommonGrpcService.DeepCopyInto(&out.CommonGrpcService)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonAccessLogGrpcService.
+func (in *CommonAccessLogGrpcService) DeepCopy() *CommonAccessLogGrpcService {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonAccessLogGrpcService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonGrpcService) DeepCopyInto(out *CommonGrpcService) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Authority != nil {
+		in, out := &in.Authority, &out.Authority
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxReceiveMessageLength != nil {
+		in, out := &in.MaxReceiveMessageLength, &out.MaxReceiveMessageLength
 		*out = new(int32)
 		**out = **in
 	}
@@ -958,6 +1428,41 @@ func (in *Compression) DeepCopy() *Compression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimits) DeepCopyInto(out *ConnectionLimits) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(TokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerSourceRateLimit != nil {
+		in, out := &in.PerSourceRateLimit, &out.PerSourceRateLimit
+		*out = new(RateLimitDescriptorLimit)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimits.
+func (in *ConnectionLimits) DeepCopy() *ConnectionLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cookie) DeepCopyInto(out *Cookie) {
 	*out = *in
@@ -1228,6 +1733,92 @@ func (in *DirectResponseStatus) DeepCopy() *DirectResponseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DnsConfig) DeepCopyInto(out *DnsConfig) {
+	*out = *in
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(DnsResolution)
+		**out = **in
+	}
+	if in.RefreshRate != nil {
+		in, out := &in.RefreshRate, &out.RefreshRate
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RespectTTL != nil {
+		in, out := &in.RespectTTL, &out.RespectTTL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FailureRefreshRate != nil {
+		in, out := &in.FailureRefreshRate, &out.FailureRefreshRate
+		*out = new(DnsFailureRefreshRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LookupFamily != nil {
+		in, out := &in.LookupFamily, &out.LookupFamily
+		*out = new(DnsLookupFamily)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DnsConfig.
+func (in *DnsConfig) DeepCopy() *DnsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DnsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DnsFailureRefreshRate) DeepCopyInto(out *DnsFailureRefreshRate) {
+	*out = *in
+	out.BaseInterval = in.BaseInterval
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DnsFailureRefreshRate.
+func (in *DnsFailureRefreshRate) DeepCopy() *DnsFailureRefreshRate {
+	if in == nil {
+		return nil
+	}
+	out := new(DnsFailureRefreshRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DnsSrvBackend) DeepCopyInto(out *DnsSrvBackend) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AppProtocol != nil {
+		in, out := &in.AppProtocol, &out.AppProtocol
+		*out = new(AppProtocol)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DnsSrvBackend.
+func (in *DnsSrvBackend) DeepCopy() *DnsSrvBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(DnsSrvBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DurationFilter) DeepCopyInto(out *DurationFilter) {
 	*out = *in
@@ -1251,6 +1842,45 @@ func (in *DynamicForwardProxyBackend) DeepCopyInto(out *DynamicForwardProxyBacke
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(DynamicForwardProxyMode)
+		**out = **in
+	}
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]DynamicForwardProxyHostMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedPorts != nil {
+		in, out := &in.AllowedPorts, &out.AllowedPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedHosts != nil {
+		in, out := &in.DeniedHosts, &out.DeniedHosts
+		*out = make([]DynamicForwardProxyHostMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxHosts != nil {
+		in, out := &in.MaxHosts, &out.MaxHosts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HostTTL != nil {
+		in, out := &in.HostTTL, &out.HostTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DnsCache != nil {
+		in, out := &in.DnsCache, &out.DnsCache
+		*out = new(DynamicForwardProxyDnsCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyBackend.
@@ -1263,6 +1893,51 @@ func (in *DynamicForwardProxyBackend) DeepCopy() *DynamicForwardProxyBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicForwardProxyDnsCache) DeepCopyInto(out *DynamicForwardProxyDnsCache) {
+	*out = *in
+	if in.RefreshRate != nil {
+		in, out := &in.RefreshRate, &out.RefreshRate
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyDnsCache.
+func (in *DynamicForwardProxyDnsCache) DeepCopy() *DynamicForwardProxyDnsCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicForwardProxyDnsCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicForwardProxyHostMatch) DeepCopyInto(out *DynamicForwardProxyHostMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(DynamicForwardProxyHostMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyHostMatch.
+func (in *DynamicForwardProxyHostMatch) DeepCopy() *DynamicForwardProxyHostMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicForwardProxyHostMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvironmentResourceDetectorConfig) DeepCopyInto(out *EnvironmentResourceDetectorConfig) {
 	*out = *in
@@ -1369,6 +2044,118 @@ func (in *EnvoyHealthCheck) DeepCopy() *EnvoyHealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageBody) DeepCopyInto(out *ErrorPageBody) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ErrorPageConfigMapSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageBody.
+func (in *ErrorPageBody) DeepCopy() *ErrorPageBody {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageConfigMapSource) DeepCopyInto(out *ErrorPageConfigMapSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageConfigMapSource.
+func (in *ErrorPageConfigMapSource) DeepCopy() *ErrorPageConfigMapSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageConfigMapSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageRule) DeepCopyInto(out *ErrorPageRule) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]ErrorPageResponseFlag, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(ErrorPageBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageRule.
+func (in *ErrorPageRule) DeepCopy() *ErrorPageRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPages) DeepCopyInto(out *ErrorPages) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ErrorPageRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPages.
+func (in *ErrorPages) DeepCopy() *ErrorPages {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPages)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtAuthBufferSettings) DeepCopyInto(out *ExtAuthBufferSettings) {
 	*out = *in
@@ -1619,27 +2406,226 @@ func (in *ExtSvcRetryPolicy) DeepCopy() *ExtSvcRetryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverBackend) DeepCopyInto(out *FailoverBackend) {
+	*out = *in
+	if in.BackendRefs != nil {
+		in, out := &in.BackendRefs, &out.BackendRefs
+		*out = make([]apisv1.BackendObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverBackend.
+func (in *FailoverBackend) DeepCopy() *FailoverBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
+	*out = *in
+	if in.HttpStatus != nil {
+		in, out := &in.HttpStatus, &out.HttpStatus
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GrpcStatus != nil {
+		in, out := &in.GrpcStatus, &out.GrpcStatus
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(FaultFromHeader)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
+	*out = *in
+	if in.FixedDelay != nil {
+		in, out := &in.FixedDelay, &out.FixedDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(FaultFromHeader)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultFromHeader) DeepCopyInto(out *FaultFromHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultFromHeader.
+func (in *FaultFromHeader) DeepCopy() *FaultFromHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultFromHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseRateLimit != nil {
+		in, out := &in.ResponseRateLimit, &out.ResponseRateLimit
+		*out = new(FaultResponseRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxActiveFaults != nil {
+		in, out := &in.MaxActiveFaults, &out.MaxActiveFaults
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultResponseRateLimit) DeepCopyInto(out *FaultResponseRateLimit) {
+	*out = *in
+	if in.Kbps != nil {
+		in, out := &in.Kbps, &out.Kbps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(FaultFromHeader)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultResponseRateLimit.
+func (in *FaultResponseRateLimit) DeepCopy() *FaultResponseRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultResponseRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSink) DeepCopyInto(out *FileSink) {
 	*out = *in
-	if in.StringFormat != nil {
-		in, out := &in.StringFormat, &out.StringFormat
-		*out = new(string)
+	if in.StringFormat != nil {
+		in, out := &in.StringFormat, &out.StringFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.JsonFormat != nil {
+		in, out := &in.JsonFormat, &out.JsonFormat
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterStage) DeepCopyInto(out *FilterStage) {
+	*out = *in
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(FilterStagePlacement)
 		**out = **in
 	}
-	if in.JsonFormat != nil {
-		in, out := &in.JsonFormat, &out.JsonFormat
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
-func (in *FileSink) DeepCopy() *FileSink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStage.
+func (in *FilterStage) DeepCopy() *FilterStage {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSink)
+	out := new(FilterStage)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1699,6 +2685,36 @@ func (in *FilterType) DeepCopy() *FilterType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardClientCert) DeepCopyInto(out *ForwardClientCert) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ForwardClientCertMode)
+		**out = **in
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]ForwardClientCertDetail, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]ClientCertHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardClientCert.
+func (in *ForwardClientCert) DeepCopy() *ForwardClientCert {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardClientCert)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayExtension) DeepCopyInto(out *GatewayExtension) {
 	*out = *in
@@ -1791,6 +2807,11 @@ func (in *GatewayExtensionSpec) DeepCopyInto(out *GatewayExtensionSpec) {
 		*out = new(OAuth2Provider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(WasmProvider)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayExtensionSpec.
@@ -1924,6 +2945,58 @@ func (in *GatewayParametersStatus) DeepCopy() *GatewayParametersStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIP) DeepCopyInto(out *GeoIP) {
+	*out = *in
+	in.MaxMind.DeepCopyInto(&out.MaxMind)
+	in.Headers.DeepCopyInto(&out.Headers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIP.
+func (in *GeoIP) DeepCopy() *GeoIP {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPHeaders) DeepCopyInto(out *GeoIPHeaders) {
+	*out = *in
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(apisv1.HTTPHeaderName)
+		**out = **in
+	}
+	if in.City != nil {
+		in, out := &in.City, &out.City
+		*out = new(apisv1.HTTPHeaderName)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(apisv1.HTTPHeaderName)
+		**out = **in
+	}
+	if in.ASN != nil {
+		in, out := &in.ASN, &out.ASN
+		*out = new(apisv1.HTTPHeaderName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPHeaders.
+func (in *GeoIPHeaders) DeepCopy() *GeoIPHeaders {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPHeaders)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GracefulShutdownSpec) DeepCopyInto(out *GracefulShutdownSpec) {
 	*out = *in
@@ -1949,6 +3022,33 @@ func (in *GracefulShutdownSpec) DeepCopy() *GracefulShutdownSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GradientController) DeepCopyInto(out *GradientController) {
+	*out = *in
+	if in.SampleAggregatePercentile != nil {
+		in, out := &in.SampleAggregatePercentile, &out.SampleAggregatePercentile
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrencyLimit != nil {
+		in, out := &in.MaxConcurrencyLimit, &out.MaxConcurrencyLimit
+		*out = new(int32)
+		**out = **in
+	}
+	out.ConcurrencyUpdateInterval = in.ConcurrencyUpdateInterval
+	in.MinRTT.DeepCopyInto(&out.MinRTT)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GradientController.
+func (in *GradientController) DeepCopy() *GradientController {
+	if in == nil {
+		return nil
+	}
+	out := new(GradientController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrpcStatusFilter) DeepCopyInto(out *GrpcStatusFilter) {
 	*out = *in
@@ -1974,6 +3074,42 @@ func (in *GrpcStatusFilter) DeepCopy() *GrpcStatusFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP3Settings) DeepCopyInto(out *HTTP3Settings) {
+	*out = *in
+	if in.AltSvcMaxAge != nil {
+		in, out := &in.AltSvcMaxAge, &out.AltSvcMaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP3Settings.
+func (in *HTTP3Settings) DeepCopy() *HTTP3Settings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP3Settings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPConnectProxy) DeepCopyInto(out *HTTPConnectProxy) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPConnectProxy.
+func (in *HTTPConnectProxy) DeepCopy() *HTTPConnectProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPConnectProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPListenerPolicy) DeepCopyInto(out *HTTPListenerPolicy) {
 	*out = *in
@@ -2131,6 +3267,11 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 		*out = new(apisv1.HTTPHeaderFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GeoIP != nil {
+		in, out := &in.GeoIP, &out.GeoIP
+		*out = new(GeoIP)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSettings.
@@ -2143,6 +3284,21 @@ func (in *HTTPSettings) DeepCopy() *HTTPSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusRange) DeepCopyInto(out *HTTPStatusRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusRange.
+func (in *HTTPStatusRange) DeepCopy() *HTTPStatusRange {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HashPolicy) DeepCopyInto(out *HashPolicy) {
 	*out = *in
@@ -2269,6 +3425,21 @@ func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
 	*out = *in
 	out.Timeout = in.Timeout
 	out.Interval = in.Interval
+	if in.NoTrafficInterval != nil {
+		in, out := &in.NoTrafficInterval, &out.NoTrafficInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UnhealthyEdgeInterval != nil {
+		in, out := &in.UnhealthyEdgeInterval, &out.UnhealthyEdgeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EventLogPath != nil {
+		in, out := &in.EventLogPath, &out.EventLogPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.Http != nil {
 		in, out := &in.Http, &out.Http
 		*out = new(HealthCheckHttp)
@@ -2279,6 +3450,11 @@ func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
 		*out = new(HealthCheckGrpc)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tcp != nil {
+		in, out := &in.Tcp, &out.Tcp
+		*out = new(HealthCheckTcp)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
@@ -2329,6 +3505,11 @@ func (in *HealthCheckHttp) DeepCopyInto(out *HealthCheckHttp) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExpectedStatuses != nil {
+		in, out := &in.ExpectedStatuses, &out.ExpectedStatuses
+		*out = make([]HTTPStatusRange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckHttp.
@@ -2341,9 +3522,83 @@ func (in *HealthCheckHttp) DeepCopy() *HealthCheckHttp {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPayload) DeepCopyInto(out *HealthCheckPayload) {
+	*out = *in
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hex != nil {
+		in, out := &in.Hex, &out.Hex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPayload.
+func (in *HealthCheckPayload) DeepCopy() *HealthCheckPayload {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckPayload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckTcp) DeepCopyInto(out *HealthCheckTcp) {
+	*out = *in
+	if in.Send != nil {
+		in, out := &in.Send, &out.Send
+		*out = new(HealthCheckPayload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = make([]HealthCheckPayload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckTcp.
+func (in *HealthCheckTcp) DeepCopy() *HealthCheckTcp {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckTcp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Host) DeepCopyInto(out *Host) {
 	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Locality != nil {
+		in, out := &in.Locality, &out.Locality
+		*out = new(HostLocality)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Host.
@@ -2356,6 +3611,21 @@ func (in *Host) DeepCopy() *Host {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostLocality) DeepCopyInto(out *HostLocality) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostLocality.
+func (in *HostLocality) DeepCopy() *HostLocality {
+	if in == nil {
+		return nil
+	}
+	out := new(HostLocality)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Http1ProtocolOptions) DeepCopyInto(out *Http1ProtocolOptions) {
 	*out = *in
@@ -2772,6 +4042,11 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(StatsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Aws != nil {
+		in, out := &in.Aws, &out.Aws
+		*out = new(AwsIntegration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Agentgateway != nil {
 		in, out := &in.Agentgateway, &out.Agentgateway
 		*out = new(Agentgateway)
@@ -2834,6 +4109,21 @@ func (in *ListenerConfig) DeepCopyInto(out *ListenerConfig) {
 		*out = new(HTTPSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTP3 != nil {
+		in, out := &in.HTTP3, &out.HTTP3
+		*out = new(HTTP3Settings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ListenerTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(ConnectionLimits)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfig.
@@ -2960,6 +4250,41 @@ func (in *ListenerPortConfig) DeepCopy() *ListenerPortConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSConfig) DeepCopyInto(out *ListenerTLSConfig) {
+	*out = *in
+	if in.ClientValidation != nil {
+		in, out := &in.ClientValidation, &out.ClientValidation
+		*out = new(ClientCertificateValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardClientCert != nil {
+		in, out := &in.ForwardClientCert, &out.ForwardClientCert
+		*out = new(ForwardClientCert)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(TLSParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlpnProtocols != nil {
+		in, out := &in.AlpnProtocols, &out.AlpnProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSConfig.
+func (in *ListenerTLSConfig) DeepCopy() *ListenerTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	*out = *in
@@ -2993,29 +4318,79 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(LoadBalancerMaglevConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Random != nil {
-		in, out := &in.Random, &out.Random
-		*out = new(LoadBalancerRandomConfig)
+	if in.Random != nil {
+		in, out := &in.Random, &out.Random
+		*out = new(LoadBalancerRandomConfig)
+		**out = **in
+	}
+	if in.ClientSideWeightedRoundRobin != nil {
+		in, out := &in.ClientSideWeightedRoundRobin, &out.ClientSideWeightedRoundRobin
+		*out = new(LoadBalancerClientSideWeightedRoundRobinConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalityType != nil {
+		in, out := &in.LocalityType, &out.LocalityType
+		*out = new(LocalityType)
+		**out = **in
+	}
+	if in.CloseConnectionsOnHostSetChange != nil {
+		in, out := &in.CloseConnectionsOnHostSetChange, &out.CloseConnectionsOnHostSetChange
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClientSideWeightedRoundRobinConfig) DeepCopyInto(out *LoadBalancerClientSideWeightedRoundRobinConfig) {
+	*out = *in
+	if in.BlackoutPeriod != nil {
+		in, out := &in.BlackoutPeriod, &out.BlackoutPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WeightExpirationPeriod != nil {
+		in, out := &in.WeightExpirationPeriod, &out.WeightExpirationPeriod
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.LocalityType != nil {
-		in, out := &in.LocalityType, &out.LocalityType
-		*out = new(LocalityType)
+	if in.WeightUpdatePeriod != nil {
+		in, out := &in.WeightUpdatePeriod, &out.WeightUpdatePeriod
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.CloseConnectionsOnHostSetChange != nil {
-		in, out := &in.CloseConnectionsOnHostSetChange, &out.CloseConnectionsOnHostSetChange
-		*out = new(bool)
+	if in.ErrorUtilizationPenalty != nil {
+		in, out := &in.ErrorUtilizationPenalty, &out.ErrorUtilizationPenalty
+		*out = new(string)
 		**out = **in
 	}
+	if in.MetricNamesForComputingUtilization != nil {
+		in, out := &in.MetricNamesForComputingUtilization, &out.MetricNamesForComputingUtilization
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SlowStart != nil {
+		in, out := &in.SlowStart, &out.SlowStart
+		*out = new(SlowStart)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
-func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClientSideWeightedRoundRobinConfig.
+func (in *LoadBalancerClientSideWeightedRoundRobinConfig) DeepCopy() *LoadBalancerClientSideWeightedRoundRobinConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancer)
+	out := new(LoadBalancerClientSideWeightedRoundRobinConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3164,6 +4539,59 @@ func (in *LocalJWKS) DeepCopy() *LocalJWKS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitDescriptor) DeepCopyInto(out *LocalRateLimitDescriptor) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]LocalRateLimitDescriptorEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.TokenBucket.DeepCopyInto(&out.TokenBucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitDescriptor.
+func (in *LocalRateLimitDescriptor) DeepCopy() *LocalRateLimitDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitDescriptorEntry) DeepCopyInto(out *LocalRateLimitDescriptorEntry) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitDescriptorEntry.
+func (in *LocalRateLimitDescriptorEntry) DeepCopy() *LocalRateLimitDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalRateLimitPolicy) DeepCopyInto(out *LocalRateLimitPolicy) {
 	*out = *in
@@ -3172,6 +4600,28 @@ func (in *LocalRateLimitPolicy) DeepCopyInto(out *LocalRateLimitPolicy) {
 		*out = new(TokenBucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]LocalRateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxDynamicDescriptors != nil {
+		in, out := &in.MaxDynamicDescriptors, &out.MaxDynamicDescriptors
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ShareKey != nil {
+		in, out := &in.ShareKey, &out.ShareKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.XRateLimitHeaders != nil {
+		in, out := &in.XRateLimitHeaders, &out.XRateLimitHeaders
+		*out = new(XRateLimitHeadersStandard)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitPolicy.
@@ -3184,6 +4634,31 @@ func (in *LocalRateLimitPolicy) DeepCopy() *LocalRateLimitPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxMindGeoIPProvider) DeepCopyInto(out *MaxMindGeoIPProvider) {
+	*out = *in
+	if in.CityDBPath != nil {
+		in, out := &in.CityDBPath, &out.CityDBPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ASNDBPath != nil {
+		in, out := &in.ASNDBPath, &out.ASNDBPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxMindGeoIPProvider.
+func (in *MaxMindGeoIPProvider) DeepCopy() *MaxMindGeoIPProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxMindGeoIPProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataKey) DeepCopyInto(out *MetadataKey) {
 	*out = *in
@@ -3264,6 +4739,51 @@ func (in *MetadataPathSegment) DeepCopy() *MetadataPathSegment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinRTTCalculation) DeepCopyInto(out *MinRTTCalculation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FixedValue != nil {
+		in, out := &in.FixedValue, &out.FixedValue
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestCount != nil {
+		in, out := &in.RequestCount, &out.RequestCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinConcurrency != nil {
+		in, out := &in.MinConcurrency, &out.MinConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinRTTCalculation.
+func (in *MinRTTCalculation) DeepCopy() *MinRTTCalculation {
+	if in == nil {
+		return nil
+	}
+	out := new(MinRTTCalculation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedJWTProvider) DeepCopyInto(out *NamedJWTProvider) {
 	*out = *in
@@ -3280,6 +4800,58 @@ func (in *NamedJWTProvider) DeepCopy() *NamedJWTProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAuthorization) DeepCopyInto(out *NetworkAuthorization) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NetworkAuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAuthorization.
+func (in *NetworkAuthorization) DeepCopy() *NetworkAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAuthorizationRule) DeepCopyInto(out *NetworkAuthorizationRule) {
+	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]apisv1.Hostname, len(*in))
+		copy(*out, *in)
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAuthorizationRule.
+func (in *NetworkAuthorizationRule) DeepCopy() *NetworkAuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2CookieConfig) DeepCopyInto(out *OAuth2CookieConfig) {
 	*out = *in
@@ -3747,6 +5319,41 @@ func (in *ProxyProtocolConfig) DeepCopy() *ProxyProtocolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyProtocolPassThroughTLVs) DeepCopyInto(out *ProxyProtocolPassThroughTLVs) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyProtocolPassThroughTLVs.
+func (in *ProxyProtocolPassThroughTLVs) DeepCopy() *ProxyProtocolPassThroughTLVs {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyProtocolPassThroughTLVs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyProtocolTLV) DeepCopyInto(out *ProxyProtocolTLV) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyProtocolTLV.
+func (in *ProxyProtocolTLV) DeepCopy() *ProxyProtocolTLV {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyProtocolTLV)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
@@ -3782,6 +5389,11 @@ func (in *RateLimitDescriptor) DeepCopyInto(out *RateLimitDescriptor) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(RateLimitDescriptorLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptor.
@@ -3834,6 +5446,21 @@ func (in *RateLimitDescriptorEntryGeneric) DeepCopy() *RateLimitDescriptorEntryG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorLimit) DeepCopyInto(out *RateLimitDescriptorLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorLimit.
+func (in *RateLimitDescriptorLimit) DeepCopy() *RateLimitDescriptorLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 	*out = *in
@@ -3844,32 +5471,126 @@ func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.ExtensionRef.DeepCopyInto(&out.ExtensionRef)
+	in.ExtensionRef.DeepCopyInto(&out.ExtensionRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitProvider) DeepCopyInto(out *RateLimitProvider) {
+	*out = *in
+	in.GrpcService.DeepCopyInto(&out.GrpcService)
+	if in.Builtin != nil {
+		in, out := &in.Builtin, &out.Builtin
+		*out = new(BuiltinRateLimitService)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitProvider.
+func (in *RateLimitProvider) DeepCopy() *RateLimitProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisDownstreamAuth) DeepCopyInto(out *RedisDownstreamAuth) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisDownstreamAuth.
+func (in *RedisDownstreamAuth) DeepCopy() *RedisDownstreamAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisDownstreamAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPrefixRoute) DeepCopyInto(out *RedisPrefixRoute) {
+	*out = *in
+	if in.RemovePrefix != nil {
+		in, out := &in.RemovePrefix, &out.RemovePrefix
+		*out = new(bool)
+		**out = **in
+	}
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.ReadBackendRef != nil {
+		in, out := &in.ReadBackendRef, &out.ReadBackendRef
+		*out = new(apisv1.BackendObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
-func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPrefixRoute.
+func (in *RedisPrefixRoute) DeepCopy() *RedisPrefixRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitPolicy)
+	out := new(RedisPrefixRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitProvider) DeepCopyInto(out *RateLimitProvider) {
+func (in *RedisProxy) DeepCopyInto(out *RedisProxy) {
 	*out = *in
-	in.GrpcService.DeepCopyInto(&out.GrpcService)
-	out.Timeout = in.Timeout
+	if in.OperationTimeout != nil {
+		in, out := &in.OperationTimeout, &out.OperationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CommandStats != nil {
+		in, out := &in.CommandStats, &out.CommandStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadPolicy != nil {
+		in, out := &in.ReadPolicy, &out.ReadPolicy
+		*out = new(RedisReadPolicy)
+		**out = **in
+	}
+	if in.ReadBackendRef != nil {
+		in, out := &in.ReadBackendRef, &out.ReadBackendRef
+		*out = new(apisv1.BackendObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixRoutes != nil {
+		in, out := &in.PrefixRoutes, &out.PrefixRoutes
+		*out = make([]RedisPrefixRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DownstreamAuth != nil {
+		in, out := &in.DownstreamAuth, &out.DownstreamAuth
+		*out = new(RedisDownstreamAuth)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitProvider.
-func (in *RateLimitProvider) DeepCopy() *RateLimitProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisProxy.
+func (in *RedisProxy) DeepCopy() *RedisProxy {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitProvider)
+	out := new(RedisProxy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3998,6 +5719,23 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.RetriableRequestHeaders != nil {
+		in, out := &in.RetriableRequestHeaders, &out.RetriableRequestHeaders
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostSelection != nil {
+		in, out := &in.HostSelection, &out.HostSelection
+		*out = new(RetryHostSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HedgeOnPerTryTimeout != nil {
+		in, out := &in.HedgeOnPerTryTimeout, &out.HedgeOnPerTryTimeout
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -4031,6 +5769,51 @@ func (in *RetryBackoff) DeepCopy() *RetryBackoff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+	if in.BudgetPercent != nil {
+		in, out := &in.BudgetPercent, &out.BudgetPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRetryConcurrency != nil {
+		in, out := &in.MinRetryConcurrency, &out.MinRetryConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryHostSelection) DeepCopyInto(out *RetryHostSelection) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryHostSelection.
+func (in *RetryHostSelection) DeepCopy() *RetryHostSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryHostSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
@@ -4407,6 +6190,21 @@ func (in *StatusCodeFilter) DeepCopy() *StatusCodeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubjectAltNameMatch) DeepCopyInto(out *SubjectAltNameMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectAltNameMatch.
+func (in *SubjectAltNameMatch) DeepCopy() *SubjectAltNameMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SubjectAltNameMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	*out = *in
@@ -4797,6 +6595,21 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(shared.Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkAuthorization != nil {
+		in, out := &in.NetworkAuthorization, &out.NetworkAuthorization
+		*out = new(NetworkAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisProxy != nil {
+		in, out := &in.RedisProxy, &out.RedisProxy
+		*out = new(RedisProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UDPSession != nil {
+		in, out := &in.UDPSession, &out.UDPSession
+		*out = new(UDPSession)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.JWT != nil {
 		in, out := &in.JWT, &out.JWT
 		*out = new(JWTAuthentication)
@@ -4827,6 +6640,41 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(OAuth2Policy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FaultInjection != nil {
+		in, out := &in.FaultInjection, &out.FaultInjection
+		*out = new(FaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(WasmPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorPages != nil {
+		in, out := &in.ErrorPages, &out.ErrorPages
+		*out = new(ErrorPages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionControl != nil {
+		in, out := &in.AdmissionControl, &out.AdmissionControl
+		*out = new(AdmissionControl)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
@@ -4899,6 +6747,31 @@ func (in *TransformationPolicy) DeepCopy() *TransformationPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPSession) DeepCopyInto(out *UDPSession) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(UDPSessionAffinity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPSession.
+func (in *UDPSession) DeepCopy() *UDPSession {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLRewrite) DeepCopyInto(out *URLRewrite) {
 	*out = *in
@@ -4938,3 +6811,198 @@ func (in *UpgradeConfig) DeepCopy() *UpgradeConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamProxyProtocol) DeepCopyInto(out *UpstreamProxyProtocol) {
+	*out = *in
+	if in.AddedTLVs != nil {
+		in, out := &in.AddedTLVs, &out.AddedTLVs
+		*out = make([]ProxyProtocolTLV, len(*in))
+		copy(*out, *in)
+	}
+	if in.PassThroughTLVs != nil {
+		in, out := &in.PassThroughTLVs, &out.PassThroughTLVs
+		*out = new(ProxyProtocolPassThroughTLVs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamProxyProtocol.
+func (in *UpstreamProxyProtocol) DeepCopy() *UpstreamProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmConfigMapSource) DeepCopyInto(out *WasmConfigMapSource) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmConfigMapSource.
+func (in *WasmConfigMapSource) DeepCopy() *WasmConfigMapSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmConfigMapSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmHTTPSource) DeepCopyInto(out *WasmHTTPSource) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmHTTPSource.
+func (in *WasmHTTPSource) DeepCopy() *WasmHTTPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmHTTPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmImageSource) DeepCopyInto(out *WasmImageSource) {
+	*out = *in
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmImageSource.
+func (in *WasmImageSource) DeepCopy() *WasmImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmModule) DeepCopyInto(out *WasmModule) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(WasmImageSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(WasmHTTPSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(WasmConfigMapSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmModule.
+func (in *WasmModule) DeepCopy() *WasmModule {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmPlugin) DeepCopyInto(out *WasmPlugin) {
+	*out = *in
+	in.ExtensionRef.DeepCopyInto(&out.ExtensionRef)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(FilterStage)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmPlugin.
+func (in *WasmPlugin) DeepCopy() *WasmPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmPolicy) DeepCopyInto(out *WasmPolicy) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]WasmPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmPolicy.
+func (in *WasmPolicy) DeepCopy() *WasmPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmProvider) DeepCopyInto(out *WasmProvider) {
+	*out = *in
+	in.Module.DeepCopyInto(&out.Module)
+	if in.RootID != nil {
+		in, out := &in.RootID, &out.RootID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmProvider.
+func (in *WasmProvider) DeepCopy() *WasmProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmProvider)
+	in.DeepCopyInto(out)
+	return out
+}
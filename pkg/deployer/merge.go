@@ -39,6 +39,7 @@ func DeepMergeGatewayParameters(dst, src *kgateway.GatewayParameters) {
 	dstKube.ServiceAccount = deepMergeServiceAccount(dstKube.GetServiceAccount(), srcKube.GetServiceAccount())
 	dstKube.Istio = deepMergeIstioIntegration(dstKube.GetIstio(), srcKube.GetIstio())
 	dstKube.Stats = deepMergeStatsConfig(dstKube.GetStats(), srcKube.GetStats())
+	dstKube.Aws = deepMergeAwsIntegration(dstKube.GetAws(), srcKube.GetAws())
 	dstKube.OmitDefaultSecurityContext = MergePointers(dstKube.GetOmitDefaultSecurityContext(), srcKube.GetOmitDefaultSecurityContext())
 	dstKube.Agentgateway = deepMergeAgentgateway(dstKube.GetAgentgateway(), srcKube.GetAgentgateway())
 }
@@ -123,6 +124,22 @@ func deepMergeStatsConfig(dst *kgateway.StatsConfig, src *kgateway.StatsConfig)
 	return dst
 }
 
+func deepMergeAwsIntegration(dst, src *kgateway.AwsIntegration) *kgateway.AwsIntegration {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.WebIdentity = MergePointers(dst.GetWebIdentity(), src.GetWebIdentity())
+	dst.PodIdentity = MergePointers(dst.GetPodIdentity(), src.GetPodIdentity())
+
+	return dst
+}
+
 func deepMergePodTemplate(dst, src *kgateway.Pod) *kgateway.Pod {
 	// nil src override means just use dst
 	if src == nil {
@@ -132,6 +132,8 @@ type HelmXds struct {
 	Host *string     `json:"host,omitempty"`
 	Port *uint32     `json:"port,omitempty"`
 	Tls  *HelmXdsTls `json:"tls,omitempty"`
+	// ClusterName is the name of the bootstrap cluster of the control plane
+	ClusterName *string `json:"clusterName,omitempty"`
 }
 
 type HelmXdsTls struct {
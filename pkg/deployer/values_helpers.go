@@ -52,6 +52,14 @@ func GetPortsValues(gw *ir.GatewayForDeployer, gwp *kgateway.GatewayParameters,
 		gwPorts = AppendPortValue(gwPorts, port, portName, gwp)
 	}
 
+	// Add UDP ports for UDP listeners and for listeners that also accept UDP traffic, e.g. HTTP/3
+	for _, port := range gw.UDPPorts.List() {
+		if err := validate.ListenerPortForParent(port, agentgateway); err != nil {
+			continue
+		}
+		gwPorts = AppendUDPPortValue(gwPorts, port)
+	}
+
 	// Add ports from GatewayParameters.Service.Ports
 	// Merge user-defined service ports with auto-generated listener ports
 	// Without this, user-specified ports would be ignored, causing service connectivity issues
@@ -113,6 +121,23 @@ func AppendPortValue(gwPorts []HelmPort, port int32, name string, gwp *kgateway.
 	})
 }
 
+// AppendUDPPortValue adds a UDP port, unless it was already added. Its name is derived from the port
+// number, as the listener name is taken by the TCP port.
+func AppendUDPPortValue(gwPorts []HelmPort, port int32) []HelmPort {
+	if slices.IndexFunc(gwPorts, func(p HelmPort) bool { return *p.Port == port && ptr.Deref(p.Protocol, "") == "UDP" }) != -1 {
+		return gwPorts
+	}
+
+	portName := SanitizePortName(fmt.Sprintf("udp-%d", port))
+	protocol := "UDP"
+	return append(gwPorts, HelmPort{
+		Port:       &port,
+		TargetPort: &port,
+		Name:       &portName,
+		Protocol:   &protocol,
+	})
+}
+
 // Convert service values from GatewayParameters into helm values to be used by the deployer.
 func GetServiceValues(svcConfig *kgateway.Service) *HelmService {
 	// convert the service type enum to its string representation;
@@ -4,10 +4,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/util/smallset"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 func TestComponentLogLevelsToString(t *testing.T) {
@@ -61,6 +64,21 @@ func TestComponentLogLevelsToString(t *testing.T) {
 	}
 }
 
+func TestGetPortsValuesWithUDPPorts(t *testing.T) {
+	gw := &ir.GatewayForDeployer{
+		ObjectSource: ir.ObjectSource{Namespace: "default", Name: "gw"},
+		Ports:        smallset.New[int32](443, 8080),
+		UDPPorts:     smallset.New[int32](443),
+	}
+
+	got := GetPortsValues(gw, nil, false)
+	assert.Equal(t, []HelmPort{
+		{Port: ptr.To[int32](443), TargetPort: ptr.To[int32](443), Name: ptr.To("listener-443"), Protocol: ptr.To("TCP")},
+		{Port: ptr.To[int32](8080), TargetPort: ptr.To[int32](8080), Name: ptr.To("listener-8080"), Protocol: ptr.To("TCP")},
+		{Port: ptr.To[int32](443), TargetPort: ptr.To[int32](443), Name: ptr.To("udp-443"), Protocol: ptr.To("UDP")},
+	}, got)
+}
+
 func TestSetLoadBalancerIPFromGateway(t *testing.T) {
 	tests := []struct {
 		name        string
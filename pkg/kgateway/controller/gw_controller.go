@@ -248,11 +248,11 @@ func NewGatewayReconciler(
 		r.agwParamClient.AddEventHandler(agwParamEventHandler)
 	}
 
-	// Custom event handler for XListenerSet changes
+	// Custom event handler for XListenerSet changes, and changes to the Wasm images used by the policies of the Gateway
 	cfg.CommonCollections.GatewayIndex.GatewaysForDeployer.Register(func(o krt.Event[ir.GatewayForDeployer]) {
 		gw := o.Latest()
 		ref := types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}
-		logger.Debug("reconciling Gateway due to XListenerSet change", "ref", ref)
+		logger.Debug("reconciling Gateway due to XListenerSet or policy change", "ref", ref)
 		r.queue.Add(ref)
 	})
 
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"path"
 	"strings"
 
 	"helm.sh/helm/v3/pkg/chart"
 	"istio.io/istio/pkg/kube/kclient"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -21,6 +24,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/helm"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 var (
@@ -431,6 +435,7 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 				Enabled: ptr.To(k.inputs.ControlPlane.XdsTLS),
 				CaCert:  ptr.To(k.inputs.ControlPlane.XdsTlsCaPath),
 			},
+			ClusterName: ptr.To(wellknown.XdsClusterName),
 		},
 	}
 	if i := gw.Spec.Infrastructure; i != nil {
@@ -525,6 +530,17 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 	gateway.Env = envoyContainerConfig.GetEnv()
 	gateway.ExtraVolumeMounts = envoyContainerConfig.ExtraVolumeMounts
 
+	// mount the Wasm modules that are distributed as OCI images and used by the policies of the Gateway
+	wasmVolumes, wasmVolumeMounts := wasmImageVolumes(irGW.WasmImages)
+	gateway.ExtraVolumes = append(gateway.ExtraVolumes, wasmVolumes...)
+	gateway.ExtraVolumeMounts = append(gateway.ExtraVolumeMounts, wasmVolumeMounts...)
+
+	// project the service account tokens used to obtain AWS credentials
+	awsVolumes, awsVolumeMounts, awsEnv := awsTokenProjection(kubeProxyConfig.GetAws())
+	gateway.ExtraVolumes = append(gateway.ExtraVolumes, awsVolumes...)
+	gateway.ExtraVolumeMounts = append(gateway.ExtraVolumeMounts, awsVolumeMounts...)
+	gateway.Env = append(gateway.Env, awsEnv...)
+
 	// istio values
 	gateway.Istio = deployer.GetIstioValues(k.inputs.IstioAutoMtlsEnabled, istioConfig)
 	gateway.SdsContainer = deployer.GetSdsContainerValues(sdsContainerConfig)
@@ -551,6 +567,81 @@ func getGatewayClassFromGateway(cli kclient.Client[*gwv1.GatewayClass], gw *gwv1
 	return gwc, nil
 }
 
+// wasmImageVolumes returns the image volumes and mounts for the Wasm modules distributed as OCI images.
+// Each module is mounted at the path of its GatewayExtension, from which the Wasm filters load it.
+func wasmImageVolumes(images []ir.WasmImage) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	for _, image := range images {
+		h := fnv.New32a()
+		h.Write([]byte(image.GatewayExtension.ResourceName()))
+		name := fmt.Sprintf("wasm-%08x", h.Sum32())
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Image: &corev1.ImageVolumeSource{
+					Reference:  image.Reference,
+					PullPolicy: image.PullPolicy,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: path.Join(wellknown.WasmModuleMountPath, image.GatewayExtension.Namespace, image.GatewayExtension.Name),
+			ReadOnly:  true,
+		})
+	}
+	return volumes, mounts
+}
+
+// awsTokenProjection returns the projected service account token volumes, mounts and environment variables
+// used by the proxy to obtain AWS credentials with AssumeRoleWithWebIdentity (IRSA) or EKS Pod Identity.
+// The tokens are mounted at the same paths as the EKS webhooks use.
+func awsTokenProjection(cfg *kgateway.AwsIntegration) ([]corev1.Volume, []corev1.VolumeMount, []corev1.EnvVar) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	var env []corev1.EnvVar
+	addTokenVolume := func(name, tokenPath, audience string, expirationSeconds *int64) {
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: ptr.To(ptr.Deref(expirationSeconds, 86400)),
+							Path:              path.Base(tokenPath),
+						},
+					}},
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: path.Dir(tokenPath),
+			ReadOnly:  true,
+		})
+	}
+
+	if webIdentity := cfg.GetWebIdentity(); webIdentity != nil {
+		addTokenVolume("aws-iam-token", wellknown.AwsWebIdentityTokenPath,
+			ptr.Deref(webIdentity.GetAudience(), wellknown.AwsWebIdentityTokenAudience), webIdentity.GetExpirationSeconds())
+		env = append(env, corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: wellknown.AwsWebIdentityTokenPath})
+		if roleArn := webIdentity.GetRoleArn(); roleArn != nil {
+			env = append(env, corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: *roleArn})
+		}
+	}
+	if podIdentity := cfg.GetPodIdentity(); podIdentity != nil {
+		addTokenVolume("eks-pod-identity-token", wellknown.AwsPodIdentityTokenPath,
+			wellknown.AwsPodIdentityTokenAudience, podIdentity.GetExpirationSeconds())
+		env = append(env,
+			corev1.EnvVar{Name: "AWS_CONTAINER_CREDENTIALS_FULL_URI", Value: wellknown.AwsPodIdentityCredentialsURI},
+			corev1.EnvVar{Name: "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE", Value: wellknown.AwsPodIdentityTokenPath},
+		)
+	}
+	return volumes, mounts, env
+}
+
 func translateInfraMeta[K ~string, V ~string](meta map[K]V) map[string]string {
 	infra := make(map[string]string, len(meta))
 	for k, v := range meta {
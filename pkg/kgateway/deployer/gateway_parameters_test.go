@@ -25,6 +25,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 )
 
@@ -202,3 +203,75 @@ func newCommonCols(t test.Failer, initObjs ...client.Object) *collections.Common
 	gateways.Gateways.WaitUntilSynced(ctx.Done())
 	return commonCols
 }
+
+func TestWasmImageVolumes(t *testing.T) {
+	images := []ir.WasmImage{
+		{
+			GatewayExtension: ir.ObjectSource{Namespace: "a", Name: "filter"},
+			Reference:        "ghcr.io/example/filter:v1",
+		},
+		{
+			GatewayExtension: ir.ObjectSource{Namespace: "b", Name: "signer"},
+			Reference:        "ghcr.io/example/signer:v1",
+			PullPolicy:       corev1.PullIfNotPresent,
+		},
+	}
+
+	volumes, mounts := wasmImageVolumes(images)
+	assert.Len(t, volumes, 2)
+	assert.Len(t, mounts, 2)
+
+	assert.Equal(t, "ghcr.io/example/filter:v1", volumes[0].Image.Reference)
+	assert.Empty(t, volumes[0].Image.PullPolicy)
+	assert.Equal(t, "/etc/kgateway/wasm/a/filter", mounts[0].MountPath)
+	assert.Equal(t, "ghcr.io/example/signer:v1", volumes[1].Image.Reference)
+	assert.Equal(t, corev1.PullIfNotPresent, volumes[1].Image.PullPolicy)
+	assert.Equal(t, "/etc/kgateway/wasm/b/signer", mounts[1].MountPath)
+
+	for i := range volumes {
+		assert.Equal(t, volumes[i].Name, mounts[i].Name)
+		assert.True(t, mounts[i].ReadOnly)
+	}
+	assert.NotEqual(t, volumes[0].Name, volumes[1].Name)
+}
+
+func TestAwsTokenProjection(t *testing.T) {
+	volumes, mounts, env := awsTokenProjection(nil)
+	assert.Empty(t, volumes)
+	assert.Empty(t, mounts)
+	assert.Empty(t, env)
+
+	volumes, mounts, env = awsTokenProjection(&kgateway.AwsIntegration{
+		WebIdentity: &kgateway.AwsWebIdentityTokenProjection{
+			RoleArn:           ptr.To("arn:aws:iam::123456789012:role/gateway"),
+			ExpirationSeconds: ptr.To(int64(3600)),
+		},
+		PodIdentity: &kgateway.AwsPodIdentityTokenProjection{},
+	})
+	assert.Len(t, volumes, 2)
+	assert.Len(t, mounts, 2)
+
+	webIdentityToken := volumes[0].Projected.Sources[0].ServiceAccountToken
+	assert.Equal(t, "sts.amazonaws.com", webIdentityToken.Audience)
+	assert.Equal(t, int64(3600), *webIdentityToken.ExpirationSeconds)
+	assert.Equal(t, "token", webIdentityToken.Path)
+	assert.Equal(t, "/var/run/secrets/eks.amazonaws.com/serviceaccount", mounts[0].MountPath)
+
+	podIdentityToken := volumes[1].Projected.Sources[0].ServiceAccountToken
+	assert.Equal(t, "pods.eks.amazonaws.com", podIdentityToken.Audience)
+	assert.Equal(t, int64(86400), *podIdentityToken.ExpirationSeconds)
+	assert.Equal(t, "eks-pod-identity-token", podIdentityToken.Path)
+	assert.Equal(t, "/var/run/secrets/pods.eks.amazonaws.com/serviceaccount", mounts[1].MountPath)
+
+	for i := range volumes {
+		assert.Equal(t, volumes[i].Name, mounts[i].Name)
+		assert.True(t, mounts[i].ReadOnly)
+	}
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"},
+		{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/gateway"},
+		{Name: "AWS_CONTAINER_CREDENTIALS_FULL_URI", Value: "http://169.254.170.23/v1/credentials"},
+		{Name: "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE", Value: "/var/run/secrets/pods.eks.amazonaws.com/serviceaccount/eks-pod-identity-token"},
+	}, env)
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
@@ -32,6 +33,9 @@ import (
 const (
 	// lambdaServiceName is the service name for the lambda filter.
 	lambdaServiceName = "lambda"
+	// awsServiceDefaultHostnameFormat is the format of the default hostname of an AWS service,
+	// taking the service name and the region.
+	awsServiceDefaultHostnameFormat = "%s.%s.amazonaws.com"
 	// lambdaFilterName is the name of the lambda filter.
 	lambdaFilterName = "envoy.filters.http.aws_lambda"
 	// awsRequestSigningFilterName is the name of the aws request signing filter.
@@ -42,9 +46,9 @@ const (
 
 // AwsIr is the internal representation of an AWS backend.
 type AwsIr struct {
-	lambdaFilters         *lambdaFilters
-	lambdaEndpoint        *lambdaEndpointConfig
-	lambdaTransportSocket *envoycorev3.TransportSocket
+	filters         *awsFilters
+	endpoint        *awsEndpointConfig
+	transportSocket *envoycorev3.TransportSocket
 }
 
 // Equals checks if two AwsIr objects are equal.
@@ -60,13 +64,13 @@ func (u *AwsIr) Equals(other any) bool {
 		if otherAws == nil {
 			return false
 		}
-		if !u.lambdaEndpoint.Equals(otherAws.lambdaEndpoint) {
+		if !u.endpoint.Equals(otherAws.endpoint) {
 			return false
 		}
-		if !u.lambdaFilters.Equals(otherAws.lambdaFilters) {
+		if !u.filters.Equals(otherAws.filters) {
 			return false
 		}
-		if !proto.Equal(u.lambdaTransportSocket, otherAws.lambdaTransportSocket) {
+		if !proto.Equal(u.transportSocket, otherAws.transportSocket) {
 			return false
 		}
 	}
@@ -83,56 +87,84 @@ func processAws(ir *AwsIr, out *envoyclusterv3.Cluster) error {
 	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
 		Type: envoyclusterv3.Cluster_LOGICAL_DNS,
 	}
-	if ir.lambdaTransportSocket != nil {
-		out.TransportSocket = ir.lambdaTransportSocket
+	if ir.transportSocket != nil {
+		out.TransportSocket = ir.transportSocket
 	}
 
 	if err := translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
-		opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
-			ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
-				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
-					Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{},
+		// lambda supports HTTP/2, but not all AWS services do (e.g. S3), so use HTTP/1.1 for the others.
+		if ir.filters.lambdaConfigAny != nil {
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+						Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{},
+					},
 				},
-			},
+			}
+		} else {
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+						HttpProtocolOptions: &envoycorev3.Http1ProtocolOptions{},
+					},
+				},
+			}
 		}
 		opts.CommonHttpProtocolOptions = &envoycorev3.HttpProtocolOptions{
 			IdleTimeout: &durationpb.Duration{
 				Seconds: 30,
 			},
 		}
-		opts.HttpFilters = append(opts.GetHttpFilters(), &envoy_hcm.HttpFilter{
-			Name: lambdaFilterName,
-			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-				TypedConfig: ir.lambdaFilters.lambdaConfigAny,
-			},
-		})
+		if ir.filters.lambdaConfigAny != nil {
+			opts.HttpFilters = append(opts.GetHttpFilters(), &envoy_hcm.HttpFilter{
+				Name: lambdaFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: ir.filters.lambdaConfigAny,
+				},
+			})
+		}
 		opts.HttpFilters = append(opts.GetHttpFilters(), &envoy_hcm.HttpFilter{
 			Name: awsRequestSigningFilterName,
 			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-				TypedConfig: ir.lambdaFilters.awsRequestSigningAny,
+				TypedConfig: ir.filters.awsRequestSigningAny,
 			},
 		})
 		opts.HttpFilters = append(opts.GetHttpFilters(), &envoy_hcm.HttpFilter{
 			Name: upstreamCodecFilterName,
 			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-				TypedConfig: ir.lambdaFilters.codecConfigAny,
+				TypedConfig: ir.filters.codecConfigAny,
 			},
 		})
 	}); err != nil {
 		return fmt.Errorf("failed to mutate http options: %v", err)
 	}
 
-	pluginutils.EnvoySingleEndpointLoadAssignment(out, ir.lambdaEndpoint.hostname, ir.lambdaEndpoint.port)
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, ir.endpoint.hostname, ir.endpoint.port)
 	return nil
 }
 
 // configureAWSAuth configures AWS authentication for the given backend.
-func configureAWSAuth(secret *ir.Secret, region string) (*envoy_request_signing_v3.AwsRequestSigning, error) {
+func configureAWSAuth(
+	auth *kgateway.AwsAuth,
+	secret *ir.Secret,
+	serviceName, region string,
+) (*envoy_request_signing_v3.AwsRequestSigning, error) {
+	if auth != nil && auth.Type != kgateway.AwsAuthTypeSecret {
+		credentialProvider, err := buildCredentialProvider(auth)
+		if err != nil {
+			return nil, err
+		}
+		return &envoy_request_signing_v3.AwsRequestSigning{
+			ServiceName:        serviceName,
+			Region:             region,
+			CredentialProvider: credentialProvider,
+		}, nil
+	}
 	// when no auth is specified, use the default aws auth provider documented by the lambda filter:
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/aws_lambda_filter#credentials.
 	if secret == nil || secret.Data == nil {
 		return &envoy_request_signing_v3.AwsRequestSigning{
-			ServiceName: lambdaServiceName,
+			ServiceName: serviceName,
 			Region:      region,
 		}, nil
 	}
@@ -143,7 +175,7 @@ func configureAWSAuth(secret *ir.Secret, region string) (*envoy_request_signing_
 	}
 
 	return &envoy_request_signing_v3.AwsRequestSigning{
-		ServiceName: lambdaServiceName,
+		ServiceName: serviceName,
 		Region:      region,
 		CredentialProvider: &envoy_aws_common_v3.AwsCredentialProvider{
 			InlineCredential: &envoy_aws_common_v3.InlineCredentialProvider{
@@ -155,16 +187,60 @@ func configureAWSAuth(secret *ir.Secret, region string) (*envoy_request_signing_
 	}, nil
 }
 
-// lambdaFilters is a helper struct to store the lambda filters for the given backend.
-type lambdaFilters struct {
+// buildCredentialProvider builds a credential provider chain that only contains the credential
+// provider of the given auth type, so that Envoy doesn't fall back to other credential sources.
+func buildCredentialProvider(auth *kgateway.AwsAuth) (*envoy_aws_common_v3.AwsCredentialProvider, error) {
+	switch auth.Type {
+	case kgateway.AwsAuthTypeWebIdentity:
+		if auth.WebIdentity == nil {
+			return nil, errors.New("webIdentity must be specified for WebIdentity auth")
+		}
+		roleArn, err := arnutils.Parse(auth.WebIdentity.RoleArn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse role arn: %v", err)
+		}
+		if roleArn.Service != "iam" {
+			return nil, fmt.Errorf("role arn %s is not an IAM role", auth.WebIdentity.RoleArn)
+		}
+		return &envoy_aws_common_v3.AwsCredentialProvider{
+			CustomCredentialProviderChain: true,
+			AssumeRoleWithWebIdentityProvider: &envoy_aws_common_v3.AssumeRoleWithWebIdentityCredentialProvider{
+				WebIdentityTokenDataSource: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{
+						Filename: ptr.Deref(auth.WebIdentity.TokenPath, wellknown.AwsWebIdentityTokenPath),
+					},
+				},
+				RoleArn:         roleArn.String(),
+				RoleSessionName: ptr.Deref(auth.WebIdentity.RoleSessionName, ""),
+			},
+		}, nil
+	case kgateway.AwsAuthTypePodIdentity:
+		// the container credential provider reads the AWS_CONTAINER_CREDENTIALS_FULL_URI and
+		// AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE environment variables set on the proxy container.
+		return &envoy_aws_common_v3.AwsCredentialProvider{
+			CustomCredentialProviderChain: true,
+			ContainerCredentialProvider:   &envoy_aws_common_v3.ContainerCredentialProvider{},
+		}, nil
+	case kgateway.AwsAuthTypeInstanceMetadata:
+		return &envoy_aws_common_v3.AwsCredentialProvider{
+			CustomCredentialProviderChain:     true,
+			InstanceProfileCredentialProvider: &envoy_aws_common_v3.InstanceProfileCredentialProvider{},
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported auth type %q", auth.Type)
+}
+
+// awsFilters is a helper struct to store the upstream HTTP filters for the given backend.
+type awsFilters struct {
+	// lambdaConfigAny is nil for backends that are not lambda functions.
 	lambdaConfigAny      *anypb.Any
 	awsRequestSigningAny *anypb.Any
 	codecConfigAny       *anypb.Any
 }
 
-// Equals checks if two lambdaFilters objects are equal.
-func (u *lambdaFilters) Equals(other *lambdaFilters) bool {
-	return cmputils.CompareWithNils(u, other, func(a, b *lambdaFilters) bool {
+// Equals checks if two awsFilters objects are equal.
+func (u *awsFilters) Equals(other *awsFilters) bool {
+	return cmputils.CompareWithNils(u, other, func(a, b *awsFilters) bool {
 		return proto.Equal(a.lambdaConfigAny, b.lambdaConfigAny) &&
 			proto.Equal(a.awsRequestSigningAny, b.awsRequestSigningAny) &&
 			proto.Equal(a.codecConfigAny, b.codecConfigAny)
@@ -175,10 +251,11 @@ func (u *lambdaFilters) Equals(other *lambdaFilters) bool {
 func buildLambdaFilters(
 	arn string,
 	region string,
+	auth *kgateway.AwsAuth,
 	secret *ir.Secret,
 	invokeMode envoy_lambda_v3.Config_InvocationMode,
 	payloadTransformMode kgateway.AWSLambdaPayloadTransformMode,
-) (*lambdaFilters, error) {
+) (*awsFilters, error) {
 	var payloadPassthrough bool
 	switch payloadTransformMode {
 	case kgateway.AWSLambdaPayloadTransformNone:
@@ -196,10 +273,38 @@ func buildLambdaFilters(
 		return nil, fmt.Errorf("failed to create lambda config: %v", err)
 	}
 
-	awsRequestSigning, err := configureAWSAuth(secret, region)
+	awsRequestSigning, err := configureAWSAuth(auth, secret, lambdaServiceName, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws request signing config: %v", err)
+	}
+	filters, err := buildAwsSigningFilters(awsRequestSigning)
+	if err != nil {
+		return nil, err
+	}
+	filters.lambdaConfigAny = lambdaConfigAny
+	return filters, nil
+}
+
+// buildServiceFilters configures cluster's upstream HTTP filters for the given AWS service backend.
+// The Host header is rewritten to the hostname of the service endpoint, since it is part of the signature.
+func buildServiceFilters(
+	in *kgateway.AwsService,
+	endpoint *awsEndpointConfig,
+	region string,
+	auth *kgateway.AwsAuth,
+	secret *ir.Secret,
+) (*awsFilters, error) {
+	awsRequestSigning, err := configureAWSAuth(auth, secret, in.Name, region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create aws request signing config: %v", err)
 	}
+	awsRequestSigning.HostRewrite = endpoint.hostname
+	awsRequestSigning.UseUnsignedPayload = ptr.Deref(in.UnsignedPayload, false)
+	return buildAwsSigningFilters(awsRequestSigning)
+}
+
+// buildAwsSigningFilters builds the request signing and upstream codec filters shared by all AWS backends.
+func buildAwsSigningFilters(awsRequestSigning *envoy_request_signing_v3.AwsRequestSigning) (*awsFilters, error) {
 	awsRequestSigningAny, err := utils.MessageToAny(awsRequestSigning)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create aws request signing config: %v", err)
@@ -210,22 +315,12 @@ func buildLambdaFilters(
 		return nil, fmt.Errorf("failed to create upstream codec config: %v", err)
 	}
 
-	return &lambdaFilters{
-		lambdaConfigAny:      lambdaConfigAny,
+	return &awsFilters{
 		awsRequestSigningAny: awsRequestSigningAny,
 		codecConfigAny:       codecConfigAny,
 	}, nil
 }
 
-// getLambdaHostname returns the hostname for the lambda function. When using a custom endpoint
-// has been specified, it will be returned. Otherwise, the default lambda hostname is returned.
-func getLambdaHostname(in *kgateway.AwsBackend) string {
-	if in.Lambda.EndpointURL != nil {
-		return *in.Lambda.EndpointURL
-	}
-	return fmt.Sprintf("lambda.%s.amazonaws.com", in.Region)
-}
-
 // getLambdaInvocationMode returns the Lambda invocation mode. Default is synchronous.
 func getLambdaInvocationMode(in *kgateway.AwsBackend) envoy_lambda_v3.Config_InvocationMode {
 	invokeMode := envoy_lambda_v3.Config_SYNCHRONOUS
@@ -249,32 +344,45 @@ func buildLambdaARN(in *kgateway.AwsBackend, region string) (string, error) {
 	return parsedARN.String(), nil
 }
 
-// lambdaEndpointConfig is a helper struct to store the endpoint configuration for the Lambda backend.
-type lambdaEndpointConfig struct {
+// awsEndpointConfig is a helper struct to store the endpoint configuration for the AWS backend.
+type awsEndpointConfig struct {
 	hostname string
 	port     uint32
 	useTLS   bool
 }
 
-// Equals checks if two lambdaEndpointConfig objects are equal.
-func (u *lambdaEndpointConfig) Equals(other *lambdaEndpointConfig) bool {
-	return u.hostname == other.hostname && u.port == other.port && u.useTLS == other.useTLS
+// Equals checks if two awsEndpointConfig objects are equal.
+func (u *awsEndpointConfig) Equals(other *awsEndpointConfig) bool {
+	return cmputils.CompareWithNils(u, other, func(a, b *awsEndpointConfig) bool {
+		return a.hostname == b.hostname && a.port == b.port && a.useTLS == b.useTLS
+	})
+}
+
+// configureAwsEndpoint returns the endpoint configuration for the AWS backend.
+func configureAwsEndpoint(in *kgateway.AwsBackend) (*awsEndpointConfig, error) {
+	if in.Service != nil {
+		return configureServiceEndpoint(in.Service, in.Region)
+	}
+	if in.Lambda.FunctionName == "" {
+		return nil, errors.New("aws backend must specify either lambda or service")
+	}
+	return configureLambdaEndpoint(in.Lambda, in.Region)
 }
 
 // configureLambdaEndpoint parses the endpoint URL and returns the endpoint configuration.
-func configureLambdaEndpoint(in *kgateway.AwsBackend) (*lambdaEndpointConfig, error) {
-	config := &lambdaEndpointConfig{
-		hostname: getLambdaHostname(in),
+func configureLambdaEndpoint(in kgateway.AwsLambda, region string) (*awsEndpointConfig, error) {
+	config := &awsEndpointConfig{
+		hostname: fmt.Sprintf(awsServiceDefaultHostnameFormat, lambdaServiceName, region),
 		port:     443,
 		useTLS:   true,
 	}
 
-	if in.Lambda.EndpointURL == nil {
+	if in.EndpointURL == nil {
 		// no custom endpoint specified, use the default lambda hostname.
 		return config, nil
 	}
 
-	inUrl := *in.Lambda.EndpointURL
+	inUrl := *in.EndpointURL
 	parsedURL, err := url.Parse(inUrl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse endpoint URL: %v", err)
@@ -291,6 +399,39 @@ func configureLambdaEndpoint(in *kgateway.AwsBackend) (*lambdaEndpointConfig, er
 	return config, nil
 }
 
+// configureServiceEndpoint parses the endpoint URL of an AWS service and returns the endpoint
+// configuration. Unlike lambda endpoints, the port is optional and defaults based on the scheme.
+func configureServiceEndpoint(in *kgateway.AwsService, region string) (*awsEndpointConfig, error) {
+	config := &awsEndpointConfig{
+		hostname: fmt.Sprintf(awsServiceDefaultHostnameFormat, in.Name, region),
+		port:     443,
+		useTLS:   true,
+	}
+
+	if in.EndpointURL == nil {
+		return config, nil
+	}
+
+	parsedURL, err := url.Parse(*in.EndpointURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint URL: %v", err)
+	}
+	config.useTLS = parsedURL.Scheme == "https"
+	config.hostname = parsedURL.Hostname()
+	if !config.useTLS {
+		config.port = 80
+	}
+	if parsedURL.Port() != "" {
+		port, err := strconv.ParseUint(parsedURL.Port(), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse port: %v", err)
+		}
+		config.port = uint32(port)
+	}
+
+	return config, nil
+}
+
 // processEndpointsAws processes the endpoints for the aws backend.
 func processEndpointsAws(_ *kgateway.AwsBackend) *ir.EndpointsForBackend {
 	return nil
@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestConfigureAwsEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        *kgateway.AwsBackend
+		expected  *awsEndpointConfig
+		expectErr bool
+	}{
+		{
+			name: "lambda with default endpoint",
+			in: &kgateway.AwsBackend{
+				Region: "us-east-1",
+				Lambda: kgateway.AwsLambda{FunctionName: "hello"},
+			},
+			expected: &awsEndpointConfig{hostname: "lambda.us-east-1.amazonaws.com", port: 443, useTLS: true},
+		},
+		{
+			name: "service with custom endpoint",
+			in: &kgateway.AwsBackend{
+				Region:  "us-east-1",
+				Service: &kgateway.AwsService{Name: "s3", EndpointURL: ptr.To("http://localhost:9000")},
+			},
+			expected: &awsEndpointConfig{hostname: "localhost", port: 9000, useTLS: false},
+		},
+		{
+			name:      "neither lambda nor service",
+			in:        &kgateway.AwsBackend{Region: "us-east-1"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := configureAwsEndpoint(tt.in)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(out), "got %+v", out)
+		})
+	}
+}
@@ -1,22 +1,53 @@
 package backend
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_dfp_cluster "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dynamic_forward_proxy/v3"
+	envoydfpcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
 	envoydfp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_forward_proxy/v3"
+	envoyrbacfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	eiutils "github.com/kgateway-dev/kgateway/v2/internal/envoyinit/pkg/utils"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+const (
+	dfpFilterName = "envoy.filters.http.dynamic_forward_proxy"
+	// dfpHostRbacFilterName is the name of the RBAC filter that enforces the allowed and denied hosts
+	// of dynamic forward proxy backends.
+	dfpHostRbacFilterName = "envoy.filters.http.rbac/dynamic_forward_proxy"
+	dfpHostsPolicyName    = "dynamic-forward-proxy-hosts"
+	// authorityHeader is matched against the allowed and denied hosts, so that requests are
+	// denied before the host is resolved.
+	authorityHeader = ":authority"
+	// anyPortRegex matches any port suffix of an authority.
+	anyPortRegex = "(?::[0-9]+)?"
+)
+
+// defaultDfpAllowedPorts is the ports of the allowed hosts when a backend doesn't set allowedPorts.
+var defaultDfpAllowedPorts = []int32{80, 443}
+
+// dfpFilterConfig is the config of the filter shared by all sub-cluster mode backends of a filter chain.
 var dfpFilterConfig = &envoydfp.FilterConfig{
 	ImplementationSpecifier: &envoydfp.FilterConfig_SubClusterConfig{
 		SubClusterConfig: &envoydfp.SubClusterConfig{},
@@ -27,6 +58,10 @@ var dfpFilterConfig = &envoydfp.FilterConfig{
 type DfpIr struct {
 	clusterTypeConfig *anypb.Any
 	transportSocket   *envoycorev3.TransportSocket
+	// dnsCacheConfig is the DNS cache of the backend. Nil in sub-cluster mode.
+	dnsCacheConfig *envoydfpcommon.DnsCacheConfig
+	// hostRbac enforces the allowed and denied hosts of the backend. Nil when all hosts are allowed.
+	hostRbac *envoyrbacfilterv3.RBACPerRoute
 }
 
 // Equals checks if two DfpIr objects are equal.
@@ -37,19 +72,36 @@ func (u *DfpIr) Equals(other any) bool {
 	}
 	return cmputils.CompareWithNils(u, otherDfp, func(a, b *DfpIr) bool {
 		return proto.Equal(a.clusterTypeConfig, b.clusterTypeConfig) &&
-			proto.Equal(a.transportSocket, b.transportSocket)
+			proto.Equal(a.transportSocket, b.transportSocket) &&
+			proto.Equal(a.dnsCacheConfig, b.dnsCacheConfig) &&
+			proto.Equal(a.hostRbac, b.hostRbac)
 	})
 }
 
-func buildDfpIr(in *kgateway.DynamicForwardProxyBackend) (*DfpIr, error) {
+// buildDfpIr builds the IR of a dynamic forward proxy backend. The DNS cache name must be
+// unique per backend, since envoy requires caches with the same name to have the same config.
+func buildDfpIr(in *kgateway.DynamicForwardProxyBackend, dnsCacheName string) (*DfpIr, error) {
 	ir := &DfpIr{}
 
-	c := &envoy_dfp_cluster.ClusterConfig{
-		ClusterImplementationSpecifier: &envoy_dfp_cluster.ClusterConfig_SubClustersConfig{
-			SubClustersConfig: &envoy_dfp_cluster.SubClustersConfig{
-				LbPolicy: envoyclusterv3.Cluster_LEAST_REQUEST,
-			},
-		},
+	c := &envoy_dfp_cluster.ClusterConfig{}
+	if ptr.Deref(in.Mode, kgateway.DynamicForwardProxyModeSubCluster) == kgateway.DynamicForwardProxyModeDnsCache {
+		ir.dnsCacheConfig = buildDnsCacheConfig(in, dnsCacheName)
+		c.ClusterImplementationSpecifier = &envoy_dfp_cluster.ClusterConfig_DnsCacheConfig{
+			DnsCacheConfig: ir.dnsCacheConfig,
+		}
+	} else {
+		subClustersConfig := &envoy_dfp_cluster.SubClustersConfig{
+			LbPolicy: envoyclusterv3.Cluster_LEAST_REQUEST,
+		}
+		if in.MaxHosts != nil {
+			subClustersConfig.MaxSubClusters = wrapperspb.UInt32(uint32(*in.MaxHosts)) // nolint:gosec // G115: kubebuilder validation ensures value >= 1
+		}
+		if in.HostTTL != nil {
+			subClustersConfig.SubClusterTtl = durationpb.New(in.HostTTL.Duration)
+		}
+		c.ClusterImplementationSpecifier = &envoy_dfp_cluster.ClusterConfig_SubClustersConfig{
+			SubClustersConfig: subClustersConfig,
+		}
 	}
 	anyCluster, err := utils.MessageToAny(c)
 	if err != nil {
@@ -57,6 +109,12 @@ func buildDfpIr(in *kgateway.DynamicForwardProxyBackend) (*DfpIr, error) {
 	}
 	ir.clusterTypeConfig = anyCluster
 
+	hostRbac, err := buildDfpHostRbac(in.AllowedHosts, in.AllowedPorts, in.DeniedHosts)
+	if err != nil {
+		return nil, err
+	}
+	ir.hostRbac = hostRbac
+
 	if ptr.Deref(in.EnableTls, false) {
 		validationContext := &envoytlsv3.CertificateValidationContext{}
 		sdsValidationCtx := &envoytlsv3.SdsSecretConfig{
@@ -89,6 +147,152 @@ func buildDfpIr(in *kgateway.DynamicForwardProxyBackend) (*DfpIr, error) {
 	return ir, nil
 }
 
+func buildDnsCacheConfig(in *kgateway.DynamicForwardProxyBackend, name string) *envoydfpcommon.DnsCacheConfig {
+	out := &envoydfpcommon.DnsCacheConfig{
+		Name: name,
+		// default to V4_PREFERRED like the other clusters that envoy resolves, as opposed to the envoy default.
+		DnsLookupFamily: envoyclusterv3.Cluster_V4_PREFERRED,
+	}
+	if in.MaxHosts != nil {
+		out.MaxHosts = wrapperspb.UInt32(uint32(*in.MaxHosts)) // nolint:gosec // G115: kubebuilder validation ensures value >= 1
+	}
+	if in.HostTTL != nil {
+		out.HostTtl = durationpb.New(in.HostTTL.Duration)
+	}
+	if in.DnsCache == nil {
+		return out
+	}
+	if in.DnsCache.RefreshRate != nil {
+		out.DnsRefreshRate = durationpb.New(in.DnsCache.RefreshRate.Duration)
+	}
+	if in.DnsCache.MaxPendingRequests != nil {
+		out.DnsCacheCircuitBreaker = &envoydfpcommon.DnsCacheCircuitBreakers{
+			MaxPendingRequests: wrapperspb.UInt32(uint32(*in.DnsCache.MaxPendingRequests)), // nolint:gosec // G115: kubebuilder validation ensures value >= 1
+		}
+	}
+	return out
+}
+
+// buildDfpHostRbac builds the per-route RBAC config that only allows requests to hosts that match
+// one of the allowed hosts on one of the allowed ports, and none of the denied hosts.
+func buildDfpHostRbac(allowed []kgateway.DynamicForwardProxyHostMatch, allowedPorts []int32, denied []kgateway.DynamicForwardProxyHostMatch) (*envoyrbacfilterv3.RBACPerRoute, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil
+	}
+	var rules []*envoyrbacv3.Permission
+	if len(allowed) > 0 {
+		if len(allowedPorts) == 0 {
+			allowedPorts = defaultDfpAllowedPorts
+		}
+		allowedHosts, err := hostPermissions(allowed, portMatchRegex(allowedPorts))
+		if err != nil {
+			return nil, fmt.Errorf("allowedHosts: %w", err)
+		}
+		rules = append(rules, allowedHosts)
+	}
+	if len(denied) > 0 {
+		// denied hosts are denied on any port.
+		deniedHosts, err := hostPermissions(denied, anyPortRegex)
+		if err != nil {
+			return nil, fmt.Errorf("deniedHosts: %w", err)
+		}
+		rules = append(rules, &envoyrbacv3.Permission{
+			Rule: &envoyrbacv3.Permission_NotRule{NotRule: deniedHosts},
+		})
+	}
+	permission := rules[0]
+	if len(rules) > 1 {
+		permission = &envoyrbacv3.Permission{
+			Rule: &envoyrbacv3.Permission_AndRules{
+				AndRules: &envoyrbacv3.Permission_Set{Rules: rules},
+			},
+		}
+	}
+	return &envoyrbacfilterv3.RBACPerRoute{
+		Rbac: &envoyrbacfilterv3.RBAC{
+			Rules: &envoyrbacv3.RBAC{
+				Action: envoyrbacv3.RBAC_ALLOW,
+				Policies: map[string]*envoyrbacv3.Policy{
+					dfpHostsPolicyName: {
+						Permissions: []*envoyrbacv3.Permission{permission},
+						Principals: []*envoyrbacv3.Principal{{
+							Identifier: &envoyrbacv3.Principal_Any{Any: true},
+						}},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// hostPermissions returns a permission that matches the authority of requests against any of the hosts,
+// with a port that matches portRegex.
+func hostPermissions(hosts []kgateway.DynamicForwardProxyHostMatch, portRegex string) (*envoyrbacv3.Permission, error) {
+	var permissions []*envoyrbacv3.Permission
+	for _, host := range hosts {
+		regex, err := hostMatchRegex(host, portRegex)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, &envoyrbacv3.Permission{
+			Rule: &envoyrbacv3.Permission_Header{
+				Header: &envoyroutev3.HeaderMatcher{
+					Name: authorityHeader,
+					HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_StringMatch{
+						StringMatch: &envoymatcherv3.StringMatcher{
+							MatchPattern: &envoymatcherv3.StringMatcher_SafeRegex{
+								SafeRegex: &envoymatcherv3.RegexMatcher{Regex: regex},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(permissions) == 1 {
+		return permissions[0], nil
+	}
+	return &envoyrbacv3.Permission{
+		Rule: &envoyrbacv3.Permission_OrRules{
+			OrRules: &envoyrbacv3.Permission_Set{Rules: permissions},
+		},
+	}, nil
+}
+
+// portMatchRegex returns a regex that matches an optional port suffix of an authority with one of the ports.
+func portMatchRegex(ports []int32) string {
+	alternatives := make([]string, 0, len(ports))
+	for _, port := range ports {
+		alternatives = append(alternatives, strconv.Itoa(int(port)))
+	}
+	return "(?::(?:" + strings.Join(alternatives, "|") + "))?"
+}
+
+// hostMatchRegex converts a host match to a regex that matches the whole authority, with an optional
+// port suffix that matches portRegex.
+func hostMatchRegex(in kgateway.DynamicForwardProxyHostMatch, portRegex string) (string, error) {
+	var regex string
+	switch ptr.Deref(in.Type, kgateway.DynamicForwardProxyHostMatchGlob) {
+	case kgateway.DynamicForwardProxyHostMatchExact:
+		regex = "(?i)" + regexp.QuoteMeta(in.Value)
+	case kgateway.DynamicForwardProxyHostMatchGlob:
+		parts := strings.Split(in.Value, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		regex = "(?i)" + strings.Join(parts, ".*")
+	case kgateway.DynamicForwardProxyHostMatchRegularExpression:
+		// validate the regex, since an invalid regex would be rejected by envoy.
+		if _, err := regexp.Compile(in.Value); err != nil {
+			return "", fmt.Errorf("invalid regular expression %q: %w", in.Value, err)
+		}
+		regex = in.Value
+	default:
+		return "", fmt.Errorf("unsupported host match type %q", *in.Type)
+	}
+	return "(?:" + regex + ")" + portRegex, nil
+}
+
 // processDynamicForwardProxy applies the DFP IR to the envoy cluster.
 func processDynamicForwardProxy(ir *DfpIr, out *envoyclusterv3.Cluster) {
 	out.LbPolicy = envoyclusterv3.Cluster_CLUSTER_PROVIDED
@@ -101,5 +305,28 @@ func processDynamicForwardProxy(ir *DfpIr, out *envoyclusterv3.Cluster) {
 
 	if ir.transportSocket != nil {
 		out.TransportSocket = ir.transportSocket
+		// use the host of every request for SNI and to verify the SAN of the upstream certificate,
+		// since the cluster connects to arbitrary hosts.
+		if err := translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+			opts.UpstreamHttpProtocolOptions = &envoycorev3.UpstreamHttpProtocolOptions{
+				AutoSni:           true,
+				AutoSanValidation: true,
+			}
+			if opts.GetUpstreamProtocolOptions() == nil {
+				opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+					ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+						ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+					},
+				}
+			}
+		}); err != nil {
+			logger.Error("failed to enable auto sni for dynamic forward proxy", "cluster", out.GetName(), "error", err)
+		}
 	}
 }
+
+// dnsCacheFilterName is the name of the filter that resolves the hosts of a DNS cache mode backend.
+// Every DNS cache has its own filter, since the filter and the cluster must use the same cache.
+func dnsCacheFilterName(cache *envoydfpcommon.DnsCacheConfig) string {
+	return dfpFilterName + "/" + cache.GetName()
+}
@@ -0,0 +1,79 @@
+package backend
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestHostMatchRegex(t *testing.T) {
+	tests := []struct {
+		name      string
+		match     kgateway.DynamicForwardProxyHostMatch
+		portRegex string
+		matches   []string
+		noMatches []string
+	}{
+		{
+			name:      "exact",
+			match:     kgateway.DynamicForwardProxyHostMatch{Type: ptr.To(kgateway.DynamicForwardProxyHostMatchExact), Value: "api.example.com"},
+			portRegex: anyPortRegex,
+			matches:   []string{"api.example.com", "API.example.com", "api.example.com:8443"},
+			noMatches: []string{"apixexample.com", "api.example.com.evil.io", "www.api.example.com"},
+		},
+		{
+			name:      "glob",
+			match:     kgateway.DynamicForwardProxyHostMatch{Value: "*.example.com"},
+			portRegex: anyPortRegex,
+			matches:   []string{"www.example.com", "a.b.example.com", "www.example.com:443"},
+			noMatches: []string{"example.com", "www.example.com.evil.io", "wwwxexample.com"},
+		},
+		{
+			name:      "default allowed ports",
+			match:     kgateway.DynamicForwardProxyHostMatch{Value: "*.example.com"},
+			portRegex: portMatchRegex(defaultDfpAllowedPorts),
+			matches:   []string{"www.example.com", "www.example.com:80", "www.example.com:443"},
+			noMatches: []string{"www.example.com:22", "www.example.com:8080", "www.example.com:4430", "www.example.com:"},
+		},
+		{
+			name:      "allowed ports",
+			match:     kgateway.DynamicForwardProxyHostMatch{Type: ptr.To(kgateway.DynamicForwardProxyHostMatchExact), Value: "api.example.com"},
+			portRegex: portMatchRegex([]int32{8443}),
+			matches:   []string{"api.example.com", "api.example.com:8443"},
+			noMatches: []string{"api.example.com:443", "api.example.com:84430"},
+		},
+		{
+			name:      "regular expression",
+			match:     kgateway.DynamicForwardProxyHostMatch{Type: ptr.To(kgateway.DynamicForwardProxyHostMatchRegularExpression), Value: `internal[0-9]+\.example\.com`},
+			portRegex: anyPortRegex,
+			matches:   []string{"internal1.example.com", "internal42.example.com:80"},
+			noMatches: []string{"internal.example.com", "xinternal1.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regex, err := hostMatchRegex(tt.match, tt.portRegex)
+			require.NoError(t, err)
+			// envoy matches the whole value against the regex.
+			re := regexp.MustCompile("^" + regex + "$")
+			for _, host := range tt.matches {
+				assert.True(t, re.MatchString(host), "expected %q to match %q", regex, host)
+			}
+			for _, host := range tt.noMatches {
+				assert.False(t, re.MatchString(host), "expected %q not to match %q", regex, host)
+			}
+		})
+	}
+
+	_, err := hostMatchRegex(kgateway.DynamicForwardProxyHostMatch{
+		Type:  ptr.To(kgateway.DynamicForwardProxyHostMatchRegularExpression),
+		Value: "(",
+	}, anyPortRegex)
+	assert.Error(t, err)
+}
@@ -0,0 +1,223 @@
+package backend
+
+import (
+	"cmp"
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+const (
+	// defaultSrvRefreshInterval is the interval at which SRV records are resolved when the
+	// backend doesn't configure one.
+	defaultSrvRefreshInterval = 30 * time.Second
+	// srvResolverTick is the granularity at which the resolver checks for names that are due.
+	srvResolverTick = time.Second
+)
+
+// srvLookupFunc resolves the SRV records of a fully qualified name.
+type srvLookupFunc func(ctx context.Context, name string) ([]*net.SRV, error)
+
+func defaultSrvLookup(ctx context.Context, name string) ([]*net.SRV, error) {
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	return records, err
+}
+
+type srvEntry struct {
+	interval   time.Duration
+	nextLookup time.Time
+	resolved   bool
+	records    []net.SRV
+}
+
+// srvResolver periodically resolves the SRV records of DnsSrv backends. Resolved records are
+// cached, and the backends that depend on them are recomputed when they change.
+type srvResolver struct {
+	lookup  srvLookupFunc
+	trigger *krt.RecomputeTrigger
+	// backends is used to stop resolving names that are no longer referenced by any backend.
+	backends krt.Collection[*kgateway.Backend]
+	wake     chan struct{}
+
+	mu      sync.Mutex
+	entries map[string]*srvEntry
+}
+
+func newSrvResolver(backends krt.Collection[*kgateway.Backend], lookup srvLookupFunc) *srvResolver {
+	return &srvResolver{
+		lookup:   lookup,
+		trigger:  krt.NewRecomputeTrigger(true),
+		backends: backends,
+		wake:     make(chan struct{}, 1),
+		entries:  map[string]*srvEntry{},
+	}
+}
+
+// Lookup returns the cached records of the name, and registers the name to be resolved
+// at the given interval. The returned records are empty until the name is first resolved.
+func (r *srvResolver) Lookup(krtctx krt.HandlerContext, name string, interval time.Duration) []net.SRV {
+	r.trigger.MarkDependant(krtctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.entries[name]
+	if !ok {
+		entry = &srvEntry{}
+		r.entries[name] = entry
+		select {
+		case r.wake <- struct{}{}:
+		default:
+		}
+	}
+	if entry.interval != interval {
+		entry.interval = interval
+		entry.nextLookup = time.Time{}
+	}
+	return entry.records
+}
+
+// Run resolves the registered names until the context is canceled.
+func (r *srvResolver) Run(ctx context.Context) {
+	ticker := time.NewTicker(srvResolverTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+		if r.resolveDue(ctx, time.Now()) {
+			r.trigger.TriggerRecomputation()
+		}
+	}
+}
+
+// resolveDue resolves the names whose refresh interval elapsed, and reports whether any
+// records changed. Records are kept when the resolution fails, so that a transient DNS
+// failure doesn't remove all the hosts of the backend.
+func (r *srvResolver) resolveDue(ctx context.Context, now time.Time) bool {
+	r.prune()
+
+	r.mu.Lock()
+	var due []string
+	for name, entry := range r.entries {
+		if !now.Before(entry.nextLookup) {
+			due = append(due, name)
+		}
+	}
+	r.mu.Unlock()
+
+	changed := false
+	for _, name := range due {
+		records, err := r.lookup(ctx, name)
+		if err != nil {
+			logger.Error("failed to resolve srv records", "name", name, "error", err)
+		}
+		sorted := sortSrvRecords(records)
+
+		r.mu.Lock()
+		entry, ok := r.entries[name]
+		if ok {
+			entry.nextLookup = now.Add(entry.interval)
+			if err == nil && (!entry.resolved || !slices.Equal(entry.records, sorted)) {
+				entry.records = sorted
+				entry.resolved = true
+				changed = true
+			}
+		}
+		r.mu.Unlock()
+	}
+	return changed
+}
+
+// prune removes the names that are no longer referenced by any DnsSrv backend.
+func (r *srvResolver) prune() {
+	if r.backends == nil {
+		return
+	}
+	referenced := map[string]bool{}
+	for _, be := range r.backends.List() {
+		if be.Spec.Type == kgateway.BackendTypeDnsSrv && be.Spec.DnsSrv != nil {
+			referenced[be.Spec.DnsSrv.Name] = true
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name := range r.entries {
+		if !referenced[name] {
+			delete(r.entries, name)
+		}
+	}
+}
+
+// sortSrvRecords returns the records in a deterministic order, so that equal record sets
+// produce equal cluster configuration.
+func sortSrvRecords(in []*net.SRV) []net.SRV {
+	out := make([]net.SRV, 0, len(in))
+	for _, record := range in {
+		if record != nil {
+			out = append(out, *record)
+		}
+	}
+	slices.SortFunc(out, func(a, b net.SRV) int {
+		return cmp.Or(
+			cmp.Compare(a.Priority, b.Priority),
+			cmp.Compare(a.Target, b.Target),
+			cmp.Compare(a.Port, b.Port),
+			cmp.Compare(a.Weight, b.Weight),
+		)
+	})
+	return out
+}
+
+// srvRecordsToHosts converts SRV records to static backend hosts. Records with the target "."
+// or port 0 indicate that the service is unavailable, and are skipped.
+func srvRecordsToHosts(records []net.SRV) []kgateway.Host {
+	var hosts []kgateway.Host
+	for _, record := range records {
+		target := strings.TrimSuffix(record.Target, ".")
+		if target == "" || record.Port == 0 {
+			continue
+		}
+		hosts = append(hosts, kgateway.Host{
+			Host:     target,
+			Port:     gwv1.PortNumber(record.Port),
+			Weight:   ptr.To(int32(max(record.Weight, 1))),
+			Priority: ptr.To(int32(record.Priority)),
+		})
+	}
+	return hosts
+}
+
+// buildDnsSrvIr builds the static IR of a DnsSrv backend from the resolved records.
+// The targets of SRV records are hostnames, so they are resolved by envoy with strict DNS.
+func buildDnsSrvIr(records []net.SRV) (*StaticIr, error) {
+	staticIr, err := buildStaticIr(&kgateway.StaticBackend{Hosts: srvRecordsToHosts(records)})
+	if err != nil {
+		return nil, err
+	}
+	staticIr.clusterType = envoyclusterv3.Cluster_STRICT_DNS
+	if staticIr.loadAssignment == nil {
+		staticIr.loadAssignment = &envoyendpointv3.ClusterLoadAssignment{}
+	}
+	return staticIr, nil
+}
+
+func srvRefreshInterval(in *kgateway.DnsSrvBackend) time.Duration {
+	if in.RefreshInterval == nil {
+		return defaultSrvRefreshInterval
+	}
+	return in.RefreshInterval.Duration
+}
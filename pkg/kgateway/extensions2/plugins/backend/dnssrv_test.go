@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestSrvResolver(t *testing.T) {
+	const name = "_http._tcp.legacy.example.com"
+	var lookupErr error
+	records := []*net.SRV{
+		{Target: "b.example.com.", Port: 8080, Priority: 10, Weight: 0},
+		{Target: "a.example.com.", Port: 8080, Priority: 10, Weight: 20},
+		{Target: "c.example.com.", Port: 9090, Priority: 0, Weight: 5},
+	}
+	lookups := 0
+	r := newSrvResolver(nil, func(_ context.Context, lookupName string) ([]*net.SRV, error) {
+		assert.Equal(t, name, lookupName)
+		lookups++
+		return records, lookupErr
+	})
+	krtctx := krt.TestingDummyContext{}
+
+	assert.Empty(t, r.Lookup(krtctx, name, time.Minute))
+
+	now := time.Now()
+	require.True(t, r.resolveDue(context.Background(), now))
+	resolved := r.Lookup(krtctx, name, time.Minute)
+	require.Len(t, resolved, 3)
+	assert.Equal(t, "c.example.com.", resolved[0].Target)
+	assert.Equal(t, "a.example.com.", resolved[1].Target)
+	assert.Equal(t, "b.example.com.", resolved[2].Target)
+
+	// not due yet
+	assert.False(t, r.resolveDue(context.Background(), now.Add(time.Second)))
+	assert.Equal(t, 1, lookups)
+
+	// failures keep the previous records
+	lookupErr = errors.New("timeout")
+	assert.False(t, r.resolveDue(context.Background(), now.Add(time.Minute)))
+	assert.Len(t, r.Lookup(krtctx, name, time.Minute), 3)
+
+	lookupErr = nil
+	records = records[:1]
+	assert.True(t, r.resolveDue(context.Background(), now.Add(2*time.Minute)))
+	assert.Len(t, r.Lookup(krtctx, name, time.Minute), 1)
+}
+
+func TestBuildDnsSrvIr(t *testing.T) {
+	hosts := srvRecordsToHosts([]net.SRV{
+		{Target: "a.example.com.", Port: 8080, Priority: 0, Weight: 0},
+		{Target: "b.example.com.", Port: 8080, Priority: 1, Weight: 30},
+		{Target: ".", Port: 8080},
+		{Target: "c.example.com.", Port: 0},
+	})
+	assert.Equal(t, []kgateway.Host{
+		{Host: "a.example.com", Port: 8080, Weight: ptr.To[int32](1), Priority: ptr.To[int32](0)},
+		{Host: "b.example.com", Port: 8080, Weight: ptr.To[int32](30), Priority: ptr.To[int32](1)},
+	}, hosts)
+
+	staticIr, err := buildDnsSrvIr(nil)
+	require.NoError(t, err)
+	assert.Equal(t, envoyclusterv3.Cluster_STRICT_DNS, staticIr.clusterType)
+	require.NotNil(t, staticIr.loadAssignment)
+	assert.Empty(t, staticIr.loadAssignment.GetEndpoints())
+}
@@ -0,0 +1,203 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyaggregatev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/aggregate/v3"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const aggregateClusterTypeName = "envoy.clusters.aggregate"
+
+// FailoverIr is the internal representation of a failover backend.
+type FailoverIr struct {
+	spec *kgateway.FailoverBackend
+	// refErrors are the errors of the backendRefs that can't be resolved, when other backendRefs
+	// can still serve traffic.
+	refErrors []error
+}
+
+// Equals checks if two FailoverIr objects are equal.
+func (u *FailoverIr) Equals(other any) bool {
+	otherFailover, ok := other.(*FailoverIr)
+	if !ok {
+		return false
+	}
+	return cmputils.CompareWithNils(u, otherFailover, func(a, b *FailoverIr) bool {
+		return reflect.DeepEqual(a.spec, b.spec) &&
+			slices.EqualFunc(a.refErrors, b.refErrors, func(x, y error) bool { return x.Error() == y.Error() })
+	})
+}
+
+// failoverRefValidator checks that the backendRefs of failover backends can be resolved, so that
+// the refs that can't be are reported on the status of the backend. The backend index can't be used
+// here, since it contains the backends of this plugin.
+type failoverRefValidator struct {
+	backends  krt.Collection[*kgateway.Backend]
+	services  krt.Collection[*corev1.Service]
+	refGrants *krtcollections.RefGrantIndex
+}
+
+// buildFailoverIr builds the failover IR. It returns the errors of the backendRefs if none of them can be
+// resolved, since the backend then can't serve any traffic.
+func buildFailoverIr(
+	krtctx krt.HandlerContext,
+	validator *failoverRefValidator,
+	src ir.ObjectSource,
+	in *kgateway.FailoverBackend,
+) (*FailoverIr, []error) {
+	out := &FailoverIr{
+		spec: in.DeepCopy(),
+	}
+	var errs []error
+	for _, ref := range in.BackendRefs {
+		if err := validator.validate(krtctx, src, ref); err != nil {
+			errs = append(errs, fmt.Errorf("backendRef %s: %w", ref.Name, err))
+		}
+	}
+	if len(errs) == len(in.BackendRefs) {
+		return out, errs
+	}
+	out.refErrors = errs
+	return out, nil
+}
+
+// validate checks that the backendRef references a Service port or a Backend that is supported as a
+// failover target, and that it is allowed by a ReferenceGrant if it is in another namespace.
+func (v *failoverRefValidator) validate(krtctx krt.HandlerContext, src ir.ObjectSource, ref gwv1.BackendObjectReference) error {
+	to := ir.ObjectSource{
+		Group:     string(ptr.Deref(ref.Group, "")),
+		Kind:      string(ptr.Deref(ref.Kind, wellknown.ServiceKind)),
+		Namespace: string(ptr.Deref(ref.Namespace, gwv1.Namespace(src.Namespace))),
+		Name:      string(ref.Name),
+	}
+	if !v.refGrants.ReferenceAllowed(krtctx, src.GetGroupKind(), src.Namespace, to) {
+		return krtcollections.ErrMissingReferenceGrant
+	}
+	key := to.Namespace + "/" + to.Name
+	switch to.GetGroupKind() {
+	case wellknown.ServiceGVK.GroupKind():
+		svc := krt.FetchOne(krtctx, v.services, krt.FilterKey(key))
+		if svc == nil {
+			return sdk.ErrNotFound
+		}
+		if !slices.ContainsFunc((*svc).Spec.Ports, func(p corev1.ServicePort) bool { return p.Port == int32(ptr.Deref(ref.Port, 0)) }) {
+			return fmt.Errorf("port %d not found", ptr.Deref(ref.Port, 0))
+		}
+	case wellknown.BackendGVK.GroupKind():
+		target := krt.FetchOne(krtctx, v.backends, krt.FilterKey(key))
+		if target == nil {
+			return sdk.ErrNotFound
+		}
+		switch (*target).Spec.Type {
+		case kgateway.BackendTypeFailover, kgateway.BackendTypeDynamicForwardProxy:
+			return fmt.Errorf("%s backends are not supported as failover targets", (*target).Spec.Type)
+		}
+	default:
+		return krtcollections.ErrUnknownBackendKind
+	}
+	return nil
+}
+
+// processFailover configures the cluster as an aggregate cluster. The clusters that the
+// aggregate cluster fails over between are resolved per client in buildResolveFailoverClusters,
+// since resolving them requires the backend index.
+func processFailover(out *envoyclusterv3.Cluster) {
+	out.LbPolicy = envoyclusterv3.Cluster_CLUSTER_PROVIDED
+	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_ClusterType{
+		ClusterType: &envoyclusterv3.Cluster_CustomClusterType{
+			Name:        aggregateClusterTypeName,
+			TypedConfig: utils.MustMessageToAny(&envoyaggregatev3.ClusterConfig{}),
+		},
+	}
+}
+
+// buildResolveFailoverClusters returns a function that resolves the backendRefs of a failover
+// backend to the names of their clusters, in order of priority. The backend index is read
+// lazily, since it is only initialized after the plugins are created.
+func buildResolveFailoverClusters(commoncol *collections.CommonCollections) sdk.PerClientProcessBackend {
+	return func(kctx krt.HandlerContext, _ context.Context, _ ir.UniqlyConnectedClient, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
+		be, ok := in.Obj.(*kgateway.Backend)
+		if !ok || be.Spec.Type != kgateway.BackendTypeFailover || be.Spec.Failover == nil {
+			return
+		}
+		clusters, errs := resolveFailoverClusters(commoncol.BackendIndex, kctx, in.ObjectSource, be.Spec.Failover)
+		for _, err := range errs {
+			logger.Error("failed to resolve failover backendRef", "backend", in.ResourceName(), "error", err)
+		}
+		if len(clusters) == 0 {
+			// an aggregate cluster requires at least one cluster, so fall back to a cluster
+			// without any hosts.
+			logger.Error("failover backend has no resolvable backendRefs", "backend", in.ResourceName())
+			out.LbPolicy = envoyclusterv3.Cluster_ROUND_ROBIN
+			out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
+				Type: envoyclusterv3.Cluster_STATIC,
+			}
+			out.LoadAssignment = &envoyendpointv3.ClusterLoadAssignment{
+				ClusterName: out.GetName(),
+				Endpoints:   []*envoyendpointv3.LocalityLbEndpoints{},
+			}
+			return
+		}
+		out.ClusterDiscoveryType = &envoyclusterv3.Cluster_ClusterType{
+			ClusterType: &envoyclusterv3.Cluster_CustomClusterType{
+				Name: aggregateClusterTypeName,
+				TypedConfig: utils.MustMessageToAny(&envoyaggregatev3.ClusterConfig{
+					Clusters: clusters,
+				}),
+			},
+		}
+	}
+}
+
+// resolveFailoverClusters resolves the backendRefs of the failover backend to cluster names.
+// Refs that can't be resolved are skipped, so that the remaining backends can still serve traffic.
+// Failover and DynamicForwardProxy backends are not supported as failover targets.
+func resolveFailoverClusters(
+	backends *krtcollections.BackendIndex,
+	kctx krt.HandlerContext,
+	src ir.ObjectSource,
+	in *kgateway.FailoverBackend,
+) ([]string, []error) {
+	var clusters []string
+	var errs []error
+	seen := map[string]bool{}
+	for _, ref := range in.BackendRefs {
+		backend, err := backends.GetBackendFromRef(kctx, src, ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("backendRef %s: %w", ref.Name, err))
+			continue
+		}
+		if target, ok := backend.Obj.(*kgateway.Backend); ok {
+			switch target.Spec.Type {
+			case kgateway.BackendTypeFailover, kgateway.BackendTypeDynamicForwardProxy:
+				errs = append(errs, fmt.Errorf("backendRef %s: %s backends are not supported as failover targets", ref.Name, target.Spec.Type))
+				continue
+			}
+		}
+		name := backend.ClusterName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		clusters = append(clusters, name)
+	}
+	return clusters, errs
+}
@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestBuildFailoverIr(t *testing.T) {
+	mock := krttest.NewMock(t, []any{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "primary", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-ns", Namespace: "other"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+		},
+		&kgateway.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "secondary", Namespace: "default"},
+			Spec:       kgateway.BackendSpec{Type: kgateway.BackendTypeStatic},
+		},
+		&kgateway.Backend{
+			ObjectMeta: metav1.ObjectMeta{Name: "nested", Namespace: "default"},
+			Spec:       kgateway.BackendSpec{Type: kgateway.BackendTypeFailover},
+		},
+	})
+	validator := &failoverRefValidator{
+		backends:  krttest.GetMockCollection[*kgateway.Backend](mock),
+		services:  krttest.GetMockCollection[*corev1.Service](mock),
+		refGrants: krtcollections.NewRefGrantIndex(krttest.GetMockCollection[*gwv1b1.ReferenceGrant](mock)),
+	}
+	src := ir.ObjectSource{Group: "gateway.kgateway.dev", Kind: "Backend", Namespace: "default", Name: "failover"}
+	backendKind := func(name string) gwv1.BackendObjectReference {
+		return gwv1.BackendObjectReference{
+			Group: ptr.To(gwv1.Group("gateway.kgateway.dev")),
+			Kind:  ptr.To(gwv1.Kind("Backend")),
+			Name:  gwv1.ObjectName(name),
+		}
+	}
+
+	// refs that can't be resolved are reported, while the others still serve traffic
+	failoverIr, errs := buildFailoverIr(krt.TestingDummyContext{}, validator, src, &kgateway.FailoverBackend{
+		BackendRefs: []gwv1.BackendObjectReference{
+			{Name: "primary", Port: ptr.To[gwv1.PortNumber](8080)},
+			{Name: "primary", Port: ptr.To[gwv1.PortNumber](9090)},
+			{Name: "missing", Port: ptr.To[gwv1.PortNumber](8080)},
+			{Name: "other-ns", Namespace: ptr.To(gwv1.Namespace("other")), Port: ptr.To[gwv1.PortNumber](8080)},
+			backendKind("secondary"),
+			backendKind("nested"),
+		},
+	})
+	assert.Empty(t, errs)
+	require.Len(t, failoverIr.refErrors, 4)
+	assert.EqualError(t, failoverIr.refErrors[0], "backendRef primary: port 9090 not found")
+	assert.EqualError(t, failoverIr.refErrors[1], "backendRef missing: not found")
+	assert.ErrorIs(t, failoverIr.refErrors[2], krtcollections.ErrMissingReferenceGrant)
+	assert.EqualError(t, failoverIr.refErrors[3], "backendRef nested: Failover backends are not supported as failover targets")
+	assert.Equal(t, failoverIr.refErrors, (&backendIr{failoverIr: failoverIr}).statusErrors())
+
+	// the backend is invalid if none of the refs can be resolved
+	failoverIr, errs = buildFailoverIr(krt.TestingDummyContext{}, validator, src, &kgateway.FailoverBackend{
+		BackendRefs: []gwv1.BackendObjectReference{
+			{Name: "missing", Port: ptr.To[gwv1.PortNumber](8080)},
+			backendKind("missing"),
+		},
+	})
+	assert.Len(t, errs, 2)
+	assert.Empty(t, failoverIr.refErrors)
+}
@@ -3,12 +3,19 @@ package backend
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoydfpcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
+	envoydfp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_forward_proxy/v3"
+	envoyrbacfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,10 +41,27 @@ const (
 
 // backendIr is the internal representation of a backend.
 type backendIr struct {
-	awsIr    *AwsIr
-	staticIr *StaticIr
-	dfpIr    *DfpIr
-	errors   []error
+	awsIr      *AwsIr
+	staticIr   *StaticIr
+	dfpIr      *DfpIr
+	failoverIr *FailoverIr
+	errors     []error
+}
+
+var _ ir.HostRestrictedBackendIR = &backendIr{}
+
+// RestrictsHosts returns true for dynamic forward proxy backends with allowed or denied hosts.
+func (u *backendIr) RestrictsHosts() bool {
+	return u.dfpIr != nil && u.dfpIr.hostRbac != nil
+}
+
+// statusErrors returns the errors reported on the status of the backend. These include the backendRefs of
+// a failover backend that can't be resolved, which don't prevent the other backendRefs from serving traffic.
+func (u *backendIr) statusErrors() []error {
+	if u.failoverIr == nil {
+		return u.errors
+	}
+	return slices.Concat(u.errors, u.failoverIr.refErrors)
 }
 
 func (u *backendIr) Equals(other any) bool {
@@ -57,10 +81,14 @@ func (u *backendIr) Equals(other any) bool {
 	if !u.dfpIr.Equals(otherBackend.dfpIr) {
 		return false
 	}
+	// Failover
+	if !u.failoverIr.Equals(otherBackend.failoverIr) {
+		return false
+	}
 	return true
 }
 
-func NewPlugin(commoncol *collections.CommonCollections) sdk.Plugin {
+func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sdk.Plugin {
 	cli := kclient.NewFilteredDelayed[*kgateway.Backend](
 		commoncol.Client,
 		wellknown.BackendGVR,
@@ -70,7 +98,14 @@ func NewPlugin(commoncol *collections.CommonCollections) sdk.Plugin {
 	col := krt.WrapClient(cli, commoncol.KrtOpts.ToOptions("Backends")...)
 
 	gk := wellknown.BackendGVK.GroupKind()
-	translateFn := buildTranslateFunc(commoncol.Secrets)
+	srvResolver := newSrvResolver(col, defaultSrvLookup)
+	go srvResolver.Run(ctx)
+	failoverRefs := &failoverRefValidator{
+		backends:  col,
+		services:  commoncol.Services,
+		refGrants: commoncol.RefGrants,
+	}
+	translateFn := buildTranslateFunc(commoncol.Secrets, srvResolver, failoverRefs)
 	bcol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *kgateway.Backend) *ir.BackendObjectIR {
 		backendIR := translateFn(krtctx, i)
 		if len(backendIR.errors) > 0 {
@@ -112,6 +147,7 @@ func NewPlugin(commoncol *collections.CommonCollections) sdk.Plugin {
 			wellknown.BackendGVK.GroupKind(): {
 				Name:                      "backend",
 				NewGatewayTranslationPass: newPlug,
+				PerClientProcessBackend:   buildResolveFailoverClusters(commoncol),
 			},
 		},
 		ContributesLeaderAction: map[schema.GroupKind]func(){
@@ -124,6 +160,8 @@ func NewPlugin(commoncol *collections.CommonCollections) sdk.Plugin {
 // the plugin can use to build the envoy config.
 func buildTranslateFunc(
 	secrets *krtcollections.SecretIndex,
+	srvResolver *srvResolver,
+	failoverRefs *failoverRefValidator,
 ) func(krtctx krt.HandlerContext, i *kgateway.Backend) *backendIr {
 	return func(krtctx krt.HandlerContext, i *kgateway.Backend) *backendIr {
 		var beIr backendIr
@@ -135,26 +173,38 @@ func buildTranslateFunc(
 			}
 			beIr.staticIr = staticIr
 		case kgateway.BackendTypeDynamicForwardProxy:
-			dfpIr, err := buildDfpIr(i.Spec.DynamicForwardProxy)
+			dfpIr, err := buildDfpIr(i.Spec.DynamicForwardProxy, dnsCacheName(i))
 			if err != nil {
 				beIr.errors = append(beIr.errors, err)
 			}
 			beIr.dfpIr = dfpIr
-		case kgateway.BackendTypeAWS:
-			region := i.Spec.Aws.Region
-			invokeMode := getLambdaInvocationMode(i.Spec.Aws)
-
-			lambdaArn, err := buildLambdaARN(i.Spec.Aws, region)
+		case kgateway.BackendTypeFailover:
+			src := ir.ObjectSource{
+				Group:     wellknown.BackendGVK.Group,
+				Kind:      wellknown.BackendGVK.Kind,
+				Namespace: i.GetNamespace(),
+				Name:      i.GetName(),
+			}
+			failoverIr, errs := buildFailoverIr(krtctx, failoverRefs, src, i.Spec.Failover)
+			beIr.errors = append(beIr.errors, errs...)
+			beIr.failoverIr = failoverIr
+		case kgateway.BackendTypeDnsSrv:
+			records := srvResolver.Lookup(krtctx, i.Spec.DnsSrv.Name, srvRefreshInterval(i.Spec.DnsSrv))
+			staticIr, err := buildDnsSrvIr(records)
 			if err != nil {
 				beIr.errors = append(beIr.errors, err)
 			}
+			beIr.staticIr = staticIr
+		case kgateway.BackendTypeAWS:
+			region := i.Spec.Aws.Region
 
-			endpointConfig, err := configureLambdaEndpoint(i.Spec.Aws)
+			endpointConfig, err := configureAwsEndpoint(i.Spec.Aws)
 			if err != nil {
 				beIr.errors = append(beIr.errors, err)
+				return &beIr
 			}
 
-			var lambdaTransportSocket *envoycorev3.TransportSocket
+			var transportSocket *envoycorev3.TransportSocket
 			if endpointConfig.useTLS {
 				// TODO(yuval-k): Add verification context
 				typedConfig, err := utils.MessageToAny(&envoytlsv3.UpstreamTlsContext{
@@ -163,7 +213,7 @@ func buildTranslateFunc(
 				if err != nil {
 					beIr.errors = append(beIr.errors, err)
 				}
-				lambdaTransportSocket = &envoycorev3.TransportSocket{
+				transportSocket = &envoycorev3.TransportSocket{
 					Name: envoywellknown.TransportSocketTls,
 					ConfigType: &envoycorev3.TransportSocket_TypedConfig{
 						TypedConfig: typedConfig,
@@ -180,16 +230,29 @@ func buildTranslateFunc(
 				}
 			}
 
-			lambdaFilters, err := buildLambdaFilters(
-				lambdaArn, region, secret, invokeMode, i.Spec.Aws.Lambda.PayloadTransformMode)
-			if err != nil {
-				beIr.errors = append(beIr.errors, err)
+			var awsFilters *awsFilters
+			if i.Spec.Aws.Service != nil {
+				awsFilters, err = buildServiceFilters(i.Spec.Aws.Service, endpointConfig, region, i.Spec.Aws.Auth, secret)
+				if err != nil {
+					beIr.errors = append(beIr.errors, err)
+				}
+			} else {
+				invokeMode := getLambdaInvocationMode(i.Spec.Aws)
+				lambdaArn, err := buildLambdaARN(i.Spec.Aws, region)
+				if err != nil {
+					beIr.errors = append(beIr.errors, err)
+				}
+				awsFilters, err = buildLambdaFilters(
+					lambdaArn, region, i.Spec.Aws.Auth, secret, invokeMode, i.Spec.Aws.Lambda.PayloadTransformMode)
+				if err != nil {
+					beIr.errors = append(beIr.errors, err)
+				}
 			}
 
 			beIr.awsIr = &AwsIr{
-				lambdaEndpoint:        endpointConfig,
-				lambdaTransportSocket: lambdaTransportSocket,
-				lambdaFilters:         lambdaFilters,
+				endpoint:        endpointConfig,
+				transportSocket: transportSocket,
+				filters:         awsFilters,
 			}
 		}
 		return &beIr
@@ -212,7 +275,7 @@ func processBackendForEnvoy(ctx context.Context, in ir.BackendObjectIR, out *env
 	// TODO(tim): do we need to do anything here for AI backends?
 	spec := be.Spec
 	switch spec.Type {
-	case kgateway.BackendTypeStatic:
+	case kgateway.BackendTypeStatic, kgateway.BackendTypeDnsSrv:
 		processStatic(beIr.staticIr, out)
 	case kgateway.BackendTypeAWS:
 		if err := processAws(beIr.awsIr, out); err != nil {
@@ -221,10 +284,17 @@ func processBackendForEnvoy(ctx context.Context, in ir.BackendObjectIR, out *env
 		}
 	case kgateway.BackendTypeDynamicForwardProxy:
 		processDynamicForwardProxy(beIr.dfpIr, out)
+	case kgateway.BackendTypeFailover:
+		processFailover(out)
 	}
 	return nil
 }
 
+// dnsCacheName returns the name of the DNS cache of a dynamic forward proxy backend.
+func dnsCacheName(in *kgateway.Backend) string {
+	return fmt.Sprintf("%s_%s_%s", ExtensionName, in.GetNamespace(), in.GetName())
+}
+
 func parseAppProtocol(b *kgateway.Backend) ir.AppProtocol {
 	switch b.Spec.Type {
 	case kgateway.BackendTypeStatic:
@@ -232,6 +302,11 @@ func parseAppProtocol(b *kgateway.Backend) ir.AppProtocol {
 		if appProtocol != nil {
 			return ir.ParseAppProtocol(ptr.To(string(*appProtocol)))
 		}
+	case kgateway.BackendTypeDnsSrv:
+		appProtocol := b.Spec.DnsSrv.AppProtocol
+		if appProtocol != nil {
+			return ir.ParseAppProtocol(ptr.To(string(*appProtocol)))
+		}
 	}
 	return ir.DefaultAppProtocol
 }
@@ -261,6 +336,9 @@ func processEndpoints(be *kgateway.Backend) *ir.EndpointsForBackend {
 type backendPlugin struct {
 	ir.UnimplementedProxyTranslationPass
 	needsDfpFilter map[string]bool
+	// dnsCacheFilters are the DNS caches of the DNS cache mode backends per filter chain, by filter name.
+	dnsCacheFilters map[string]map[string]*envoydfpcommon.DnsCacheConfig
+	needsHostRbac   map[string]bool
 }
 
 var _ ir.ProxyTranslationPass = &backendPlugin{}
@@ -277,10 +355,11 @@ func (p *backendPlugin) ApplyForBackend(pCtx *ir.RouteBackendContext, in ir.Http
 	backend := pCtx.Backend.Obj.(*kgateway.Backend)
 	switch backend.Spec.Type {
 	case kgateway.BackendTypeDynamicForwardProxy:
-		if p.needsDfpFilter == nil {
-			p.needsDfpFilter = make(map[string]bool)
+		beIr, ok := pCtx.Backend.ObjIr.(*backendIr)
+		if !ok || beIr.dfpIr == nil {
+			return nil
 		}
-		p.needsDfpFilter[pCtx.FilterChainName] = true
+		p.applyDfp(pCtx, beIr.dfpIr)
 	default:
 		return nil
 	}
@@ -288,6 +367,37 @@ func (p *backendPlugin) ApplyForBackend(pCtx *ir.RouteBackendContext, in ir.Http
 	return nil
 }
 
+func (p *backendPlugin) applyDfp(pCtx *ir.RouteBackendContext, dfpIr *DfpIr) {
+	if dfpIr.hostRbac != nil {
+		if p.needsHostRbac == nil {
+			p.needsHostRbac = make(map[string]bool)
+		}
+		p.needsHostRbac[pCtx.FilterChainName] = true
+		pCtx.TypedFilterConfig.AddTypedConfig(dfpHostRbacFilterName, dfpIr.hostRbac)
+	}
+
+	if dfpIr.dnsCacheConfig == nil {
+		if p.needsDfpFilter == nil {
+			p.needsDfpFilter = make(map[string]bool)
+		}
+		p.needsDfpFilter[pCtx.FilterChainName] = true
+		return
+	}
+
+	// the filter of the DNS cache is disabled by default, and only enabled on the routes to the backend.
+	// The sub-cluster mode filter is disabled on these routes, since it can't resolve hosts of a DNS cache.
+	if p.dnsCacheFilters == nil {
+		p.dnsCacheFilters = make(map[string]map[string]*envoydfpcommon.DnsCacheConfig)
+	}
+	if p.dnsCacheFilters[pCtx.FilterChainName] == nil {
+		p.dnsCacheFilters[pCtx.FilterChainName] = make(map[string]*envoydfpcommon.DnsCacheConfig)
+	}
+	filterName := dnsCacheFilterName(dfpIr.dnsCacheConfig)
+	p.dnsCacheFilters[pCtx.FilterChainName][filterName] = dfpIr.dnsCacheConfig
+	pCtx.TypedFilterConfig.AddTypedConfig(filterName, &envoyroutev3.FilterConfig{Config: &anypb.Any{}})
+	pCtx.TypedFilterConfig.AddTypedConfig(dfpFilterName, &envoyroutev3.FilterConfig{Config: &anypb.Any{}, Disabled: true})
+}
+
 // called 1 time per listener
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from route config must be disabled, so it doesnt impact other routes.
@@ -295,9 +405,26 @@ func (p *backendPlugin) HttpFilters(_ ir.HttpFiltersContext, fc ir.FilterChainCo
 	result := []filters.StagedHttpFilter{}
 
 	var errs []error
+	if p.needsHostRbac[fc.FilterChainName] {
+		// the hosts are enforced by the per-route config of the routes to the backends. The filter runs right
+		// before the dynamic forward proxy filters, so that it checks the authority after the filters that can
+		// modify it, such as transformations, ext_proc and Wasm plugins.
+		f := filters.MustNewStagedFilter(dfpHostRbacFilterName, &envoyrbacfilterv3.RBAC{}, filters.BeforeStage(filters.OutAuthStage))
+		result = append(result, f)
+	}
 	if p.needsDfpFilter[fc.FilterChainName] {
 		pluginStage := filters.DuringStage(filters.OutAuthStage)
-		f := filters.MustNewStagedFilter("envoy.filters.http.dynamic_forward_proxy", dfpFilterConfig, pluginStage)
+		f := filters.MustNewStagedFilter(dfpFilterName, dfpFilterConfig, pluginStage)
+		result = append(result, f)
+	}
+	dnsCacheFilters := p.dnsCacheFilters[fc.FilterChainName]
+	for _, filterName := range slices.Sorted(maps.Keys(dnsCacheFilters)) {
+		f := filters.MustNewStagedFilter(filterName, &envoydfp.FilterConfig{
+			ImplementationSpecifier: &envoydfp.FilterConfig_DnsCacheConfig{
+				DnsCacheConfig: dnsCacheFilters[filterName],
+			},
+		}, filters.DuringStage(filters.OutAuthStage))
+		f.Filter.Disabled = true
 		result = append(result, f)
 	}
 	return result, errors.Join(errs...)
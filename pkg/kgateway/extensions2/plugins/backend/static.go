@@ -1,19 +1,27 @@
 package backend
 
 import (
+	"cmp"
 	"fmt"
 	"net/netip"
+	"slices"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+// envoyLbMetadataKey is the filter metadata namespace used by envoy load balancers.
+const envoyLbMetadataKey = "envoy.lb"
+
 // StaticIr is the internal representation of a static backend.
 type StaticIr struct {
 	clusterType    envoyclusterv3.Cluster_DiscoveryType
@@ -38,6 +46,13 @@ func buildStaticIr(in *kgateway.StaticBackend) (*StaticIr, error) {
 	}
 
 	var hostname string
+	// hosts are grouped into one LocalityLbEndpoints per priority and locality, in order of first appearance.
+	type localityKey struct {
+		priority int32
+		locality kgateway.HostLocality
+	}
+	var keys []localityKey
+	groups := map[localityKey]*envoyendpointv3.LocalityLbEndpoints{}
 	for _, host := range in.Hosts {
 		if host.Host == "" {
 			return nil, fmt.Errorf("addr cannot be empty for host")
@@ -55,38 +70,73 @@ func buildStaticIr(in *kgateway.StaticBackend) (*StaticIr, error) {
 			}
 		}
 
-		if ir.loadAssignment == nil {
-			ir.loadAssignment = &envoyendpointv3.ClusterLoadAssignment{
-				Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{}},
+		key := localityKey{priority: ptr.Deref(host.Priority, 0)}
+		if host.Locality != nil {
+			key.locality = *host.Locality
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &envoyendpointv3.LocalityLbEndpoints{}
+			if host.Locality != nil {
+				group.Locality = &envoycorev3.Locality{
+					Region:  host.Locality.Region,
+					Zone:    host.Locality.Zone,
+					SubZone: host.Locality.SubZone,
+				}
 			}
+			groups[key] = group
+			keys = append(keys, key)
 		}
 
 		healthCheckConfig := &envoyendpointv3.Endpoint_HealthCheckConfig{
 			Hostname: host.Host,
 		}
 
-		ir.loadAssignment.GetEndpoints()[0].LbEndpoints = append(ir.loadAssignment.GetEndpoints()[0].GetLbEndpoints(),
-			&envoyendpointv3.LbEndpoint{
-				//	Metadata: getMetadata(params.Ctx, spec, host),
-				HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
-					Endpoint: &envoyendpointv3.Endpoint{
-						Hostname: host.Host,
-						Address: &envoycorev3.Address{
-							Address: &envoycorev3.Address_SocketAddress{
-								SocketAddress: &envoycorev3.SocketAddress{
-									Protocol: envoycorev3.SocketAddress_TCP,
-									Address:  host.Host,
-									PortSpecifier: &envoycorev3.SocketAddress_PortValue{
-										PortValue: uint32(host.Port), //nolint:gosec // G115: Gateway API PortNumber is int32 with validation 1-65535, always safe
-									},
+		lbEndpoint := &envoyendpointv3.LbEndpoint{
+			Metadata: hostMetadata(host.Metadata),
+			HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+				Endpoint: &envoyendpointv3.Endpoint{
+					Hostname: host.Host,
+					Address: &envoycorev3.Address{
+						Address: &envoycorev3.Address_SocketAddress{
+							SocketAddress: &envoycorev3.SocketAddress{
+								Protocol: envoycorev3.SocketAddress_TCP,
+								Address:  host.Host,
+								PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+									PortValue: uint32(host.Port), //nolint:gosec // G115: Gateway API PortNumber is int32 with validation 1-65535, always safe
 								},
 							},
 						},
-						HealthCheckConfig: healthCheckConfig,
 					},
+					HealthCheckConfig: healthCheckConfig,
 				},
-				//				LoadBalancingWeight: host.GetLoadBalancingWeight(),
-			})
+			},
+		}
+		weight := uint32(1)
+		if host.Weight != nil {
+			weight = uint32(*host.Weight) // nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 1000
+			lbEndpoint.LoadBalancingWeight = wrapperspb.UInt32(weight)
+		}
+		group.LbEndpoints = append(group.GetLbEndpoints(), lbEndpoint)
+		// the locality weight is required for the locality to receive traffic with locality weighted load balancing.
+		group.LoadBalancingWeight = wrapperspb.UInt32(group.GetLoadBalancingWeight().GetValue() + weight)
+	}
+
+	if len(keys) > 0 {
+		// envoy requires priorities to be contiguous starting from 0, so compact the configured priorities.
+		slices.SortStableFunc(keys, func(a, b localityKey) int {
+			return cmp.Compare(a.priority, b.priority)
+		})
+		ir.loadAssignment = &envoyendpointv3.ClusterLoadAssignment{}
+		var priority uint32
+		for i, key := range keys {
+			if i > 0 && key.priority != keys[i-1].priority {
+				priority++
+			}
+			group := groups[key]
+			group.Priority = priority
+			ir.loadAssignment.Endpoints = append(ir.loadAssignment.GetEndpoints(), group)
+		}
 	}
 
 	// the upstream has a DNS name. We need Envoy to resolve the DNS name
@@ -115,6 +165,22 @@ func processStatic(ir *StaticIr, out *envoyclusterv3.Cluster) {
 	}
 }
 
+// hostMetadata returns the envoy.lb filter metadata of a static backend host.
+func hostMetadata(in map[string]string) *envoycorev3.Metadata {
+	if len(in) == 0 {
+		return nil
+	}
+	fields := make(map[string]*structpb.Value, len(in))
+	for k, v := range in {
+		fields[k] = structpb.NewStringValue(v)
+	}
+	return &envoycorev3.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			envoyLbMetadataKey: {Fields: fields},
+		},
+	}
+}
+
 func processEndpointsStatic(_ *kgateway.StaticBackend) *ir.EndpointsForBackend {
 	return nil
 }
@@ -48,7 +48,7 @@ func buildRegisterCallback(
 						return pluginsdk.ErrNotFound
 					}
 
-					newCondition := pluginutils.BuildCondition("Backend", ir.errors)
+					newCondition := pluginutils.BuildCondition("Backend", ir.statusErrors())
 
 					found := meta.FindStatusCondition(cur.Status.Conditions, string(gwv1.PolicyConditionAccepted))
 					if found != nil {
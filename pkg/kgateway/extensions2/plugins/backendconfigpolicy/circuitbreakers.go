@@ -2,6 +2,7 @@ package backendconfigpolicy
 
 import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -26,6 +27,15 @@ func translateCircuitBreakers(cb *kgateway.CircuitBreakers) *envoyclusterv3.Circ
 	if cb.MaxRetries != nil {
 		threshold.MaxRetries = wrapperspb.UInt32(uint32(*cb.MaxRetries)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
+	if cb.RetryBudget != nil {
+		threshold.RetryBudget = &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{}
+		if cb.RetryBudget.BudgetPercent != nil {
+			threshold.RetryBudget.BudgetPercent = &envoytypev3.Percent{Value: float64(*cb.RetryBudget.BudgetPercent)}
+		}
+		if cb.RetryBudget.MinRetryConcurrency != nil {
+			threshold.RetryBudget.MinRetryConcurrency = wrapperspb.UInt32(uint32(*cb.RetryBudget.MinRetryConcurrency)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+	}
 
 	return &envoyclusterv3.CircuitBreakers{
 		Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{threshold},
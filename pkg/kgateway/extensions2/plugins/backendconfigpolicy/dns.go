@@ -0,0 +1,128 @@
+package backendconfigpolicy
+
+import (
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycommondnsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/common/dns/v3"
+	envoydnsclusterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dns/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const dnsClusterTypeName = "envoy.clusters.dns"
+
+// DnsConfigIR is the internal representation of the DNS configuration of a backend.
+type DnsConfigIR struct {
+	// resolution is nil when the resolution mode of the backend is kept.
+	resolution *kgateway.DnsResolution
+	// config holds the DNS cluster configuration. The lookup family is unspecified
+	// when the lookup family of the cluster is kept.
+	config *envoydnsclusterv3.DnsCluster
+}
+
+func (d *DnsConfigIR) Equals(other *DnsConfigIR) bool {
+	return cmputils.CompareWithNils(d, other, func(a, b *DnsConfigIR) bool {
+		return cmputils.PointerValsEqual(a.resolution, b.resolution) &&
+			proto.Equal(a.config, b.config)
+	})
+}
+
+func translateDnsConfig(in *kgateway.DnsConfig) *DnsConfigIR {
+	out := &DnsConfigIR{
+		resolution: in.Resolution,
+		config: &envoydnsclusterv3.DnsCluster{
+			RespectDnsTtl: ptr.Deref(in.RespectTTL, false),
+		},
+	}
+	if in.RefreshRate != nil {
+		out.config.DnsRefreshRate = durationpb.New(in.RefreshRate.Duration)
+	}
+	if in.FailureRefreshRate != nil {
+		out.config.DnsFailureRefreshRate = &envoydnsclusterv3.DnsCluster_RefreshRate{
+			BaseInterval: durationpb.New(in.FailureRefreshRate.BaseInterval.Duration),
+		}
+		if in.FailureRefreshRate.MaxInterval != nil {
+			out.config.DnsFailureRefreshRate.MaxInterval = durationpb.New(in.FailureRefreshRate.MaxInterval.Duration)
+		}
+	}
+	if in.LookupFamily != nil {
+		switch *in.LookupFamily {
+		case kgateway.DnsLookupFamilyAuto:
+			out.config.DnsLookupFamily = envoycommondnsv3.DnsLookupFamily_AUTO
+		case kgateway.DnsLookupFamilyV4Only:
+			out.config.DnsLookupFamily = envoycommondnsv3.DnsLookupFamily_V4_ONLY
+		case kgateway.DnsLookupFamilyV6Only:
+			out.config.DnsLookupFamily = envoycommondnsv3.DnsLookupFamily_V6_ONLY
+		case kgateway.DnsLookupFamilyV4Preferred:
+			out.config.DnsLookupFamily = envoycommondnsv3.DnsLookupFamily_V4_PREFERRED
+		case kgateway.DnsLookupFamilyAll:
+			out.config.DnsLookupFamily = envoycommondnsv3.DnsLookupFamily_ALL
+		}
+	}
+	return out
+}
+
+// applyDnsConfig converts a cluster that resolves its hosts with DNS to the DNS cluster extension,
+// which supersedes the deprecated DNS fields of the cluster. Clusters that don't resolve their
+// hosts with DNS are left unchanged.
+func applyDnsConfig(in *DnsConfigIR, out *envoyclusterv3.Cluster) {
+	if in == nil {
+		return
+	}
+	cdt, ok := out.GetClusterDiscoveryType().(*envoyclusterv3.Cluster_Type)
+	if !ok || (cdt.Type != envoyclusterv3.Cluster_STRICT_DNS && cdt.Type != envoyclusterv3.Cluster_LOGICAL_DNS) {
+		return
+	}
+
+	config := proto.Clone(in.config).(*envoydnsclusterv3.DnsCluster)
+	logical := cdt.Type == envoyclusterv3.Cluster_LOGICAL_DNS
+	if in.resolution != nil {
+		logical = *in.resolution == kgateway.DnsResolutionLogical
+	}
+	if logical && countEndpoints(out) > 1 {
+		logger.Error("logical dns resolution is only supported for backends with a single host. Using strict dns resolution.",
+			"cluster", out.GetName())
+		logical = false
+	}
+	config.AllAddressesInSingleEndpoint = logical
+
+	if config.GetDnsLookupFamily() == envoycommondnsv3.DnsLookupFamily_UNSPECIFIED {
+		config.DnsLookupFamily = toCommonDnsLookupFamily(out.GetDnsLookupFamily())
+	}
+	// the lookup family is configured on the DNS cluster instead.
+	out.DnsLookupFamily = envoyclusterv3.Cluster_AUTO
+
+	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_ClusterType{
+		ClusterType: &envoyclusterv3.Cluster_CustomClusterType{
+			Name:        dnsClusterTypeName,
+			TypedConfig: utils.MustMessageToAny(config),
+		},
+	}
+}
+
+func countEndpoints(out *envoyclusterv3.Cluster) int {
+	count := 0
+	for _, localityEndpoints := range out.GetLoadAssignment().GetEndpoints() {
+		count += len(localityEndpoints.GetLbEndpoints())
+	}
+	return count
+}
+
+func toCommonDnsLookupFamily(in envoyclusterv3.Cluster_DnsLookupFamily) envoycommondnsv3.DnsLookupFamily {
+	switch in {
+	case envoyclusterv3.Cluster_V4_ONLY:
+		return envoycommondnsv3.DnsLookupFamily_V4_ONLY
+	case envoyclusterv3.Cluster_V6_ONLY:
+		return envoycommondnsv3.DnsLookupFamily_V6_ONLY
+	case envoyclusterv3.Cluster_V4_PREFERRED:
+		return envoycommondnsv3.DnsLookupFamily_V4_PREFERRED
+	case envoyclusterv3.Cluster_ALL:
+		return envoycommondnsv3.DnsLookupFamily_ALL
+	default:
+		return envoycommondnsv3.DnsLookupFamily_AUTO
+	}
+}
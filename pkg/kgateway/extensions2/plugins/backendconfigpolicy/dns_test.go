@@ -0,0 +1,128 @@
+package backendconfigpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoycommondnsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/common/dns/v3"
+	envoydnsclusterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dns/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestApplyDnsConfig(t *testing.T) {
+	endpoints := func(n int) *envoyendpointv3.ClusterLoadAssignment {
+		cla := &envoyendpointv3.ClusterLoadAssignment{
+			Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{}},
+		}
+		for range n {
+			cla.Endpoints[0].LbEndpoints = append(cla.Endpoints[0].LbEndpoints, &envoyendpointv3.LbEndpoint{})
+		}
+		return cla
+	}
+
+	tests := []struct {
+		name     string
+		config   *kgateway.DnsConfig
+		cluster  *envoyclusterv3.Cluster
+		expected *envoydnsclusterv3.DnsCluster
+	}{
+		{
+			name: "strict dns with all options",
+			config: &kgateway.DnsConfig{
+				RefreshRate: &metav1.Duration{Duration: 10 * time.Second},
+				RespectTTL:  ptr.To(true),
+				FailureRefreshRate: &kgateway.DnsFailureRefreshRate{
+					BaseInterval: metav1.Duration{Duration: time.Second},
+					MaxInterval:  &metav1.Duration{Duration: 30 * time.Second},
+				},
+				LookupFamily: ptr.To(kgateway.DnsLookupFamilyV6Only),
+			},
+			cluster: &envoyclusterv3.Cluster{
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+				DnsLookupFamily:      envoyclusterv3.Cluster_V4_PREFERRED,
+				LoadAssignment:       endpoints(2),
+			},
+			expected: &envoydnsclusterv3.DnsCluster{
+				DnsRefreshRate: durationpb.New(10 * time.Second),
+				RespectDnsTtl:  true,
+				DnsFailureRefreshRate: &envoydnsclusterv3.DnsCluster_RefreshRate{
+					BaseInterval: durationpb.New(time.Second),
+					MaxInterval:  durationpb.New(30 * time.Second),
+				},
+				DnsLookupFamily: envoycommondnsv3.DnsLookupFamily_V6_ONLY,
+			},
+		},
+		{
+			name:   "keeps the lookup family and logical resolution of the cluster",
+			config: &kgateway.DnsConfig{},
+			cluster: &envoyclusterv3.Cluster{
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_LOGICAL_DNS},
+				DnsLookupFamily:      envoyclusterv3.Cluster_V4_ONLY,
+				LoadAssignment:       endpoints(1),
+			},
+			expected: &envoydnsclusterv3.DnsCluster{
+				DnsLookupFamily:              envoycommondnsv3.DnsLookupFamily_V4_ONLY,
+				AllAddressesInSingleEndpoint: true,
+			},
+		},
+		{
+			name:   "logical resolution",
+			config: &kgateway.DnsConfig{Resolution: ptr.To(kgateway.DnsResolutionLogical)},
+			cluster: &envoyclusterv3.Cluster{
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+				LoadAssignment:       endpoints(1),
+			},
+			expected: &envoydnsclusterv3.DnsCluster{
+				DnsLookupFamily:              envoycommondnsv3.DnsLookupFamily_AUTO,
+				AllAddressesInSingleEndpoint: true,
+			},
+		},
+		{
+			name:   "logical resolution with multiple hosts falls back to strict",
+			config: &kgateway.DnsConfig{Resolution: ptr.To(kgateway.DnsResolutionLogical)},
+			cluster: &envoyclusterv3.Cluster{
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+				LoadAssignment:       endpoints(2),
+			},
+			expected: &envoydnsclusterv3.DnsCluster{
+				DnsLookupFamily: envoycommondnsv3.DnsLookupFamily_AUTO,
+			},
+		},
+		{
+			name:   "static clusters are not changed",
+			config: &kgateway.DnsConfig{Resolution: ptr.To(kgateway.DnsResolutionLogical)},
+			cluster: &envoyclusterv3.Cluster{
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STATIC},
+				DnsLookupFamily:      envoyclusterv3.Cluster_V4_PREFERRED,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := proto.Clone(tt.cluster).(*envoyclusterv3.Cluster)
+			applyDnsConfig(translateDnsConfig(tt.config), tt.cluster)
+
+			if tt.expected == nil {
+				assert.True(t, proto.Equal(original, tt.cluster), "cluster should not be changed")
+				return
+			}
+			assert.Equal(t, envoyclusterv3.Cluster_AUTO, tt.cluster.GetDnsLookupFamily())
+			customType := tt.cluster.GetClusterType()
+			require.NotNil(t, customType)
+			assert.Equal(t, dnsClusterTypeName, customType.GetName())
+			actual := &envoydnsclusterv3.DnsCluster{}
+			require.NoError(t, customType.GetTypedConfig().UnmarshalTo(actual))
+			assert.True(t, proto.Equal(tt.expected, actual), "expected %v, got %v", tt.expected, actual)
+		})
+	}
+}
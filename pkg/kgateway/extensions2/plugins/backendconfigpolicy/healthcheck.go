@@ -1,13 +1,26 @@
 package backendconfigpolicy
 
 import (
+	"fmt"
+	"regexp"
+
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhealthcheckfilesinkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/health_check/event_sinks/file/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 )
 
+const healthCheckEventFileSinkName = "envoy.health_check.event_sink.file"
+
+// healthCheckEventLogPathRegex matches the paths where the health check events can be logged:
+// the proxy stdout, or a file directly in the /var/log/envoy directory.
+var healthCheckEventLogPathRegex = regexp.MustCompile(`^(/dev/stdout|/var/log/envoy/[A-Za-z0-9][A-Za-z0-9._-]*)$`)
+
 func translateHealthCheck(hc *kgateway.HealthCheck) *envoycorev3.HealthCheck {
 	if hc == nil {
 		return nil
@@ -19,6 +32,20 @@ func translateHealthCheck(hc *kgateway.HealthCheck) *envoycorev3.HealthCheck {
 	healthCheck.Interval = durationpb.New(hc.Interval.Duration)
 	healthCheck.UnhealthyThreshold = &wrapperspb.UInt32Value{Value: uint32(hc.UnhealthyThreshold)} // nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 4294967295, safe for uint32
 	healthCheck.HealthyThreshold = &wrapperspb.UInt32Value{Value: uint32(hc.HealthyThreshold)}     // nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 4294967295, safe for uint32
+	if hc.NoTrafficInterval != nil {
+		healthCheck.NoTrafficInterval = durationpb.New(hc.NoTrafficInterval.Duration)
+	}
+	if hc.UnhealthyEdgeInterval != nil {
+		healthCheck.UnhealthyEdgeInterval = durationpb.New(hc.UnhealthyEdgeInterval.Duration)
+	}
+	if hc.EventLogPath != nil {
+		healthCheck.EventLogger = []*envoycorev3.TypedExtensionConfig{{
+			Name: healthCheckEventFileSinkName,
+			TypedConfig: utils.MustMessageToAny(&envoyhealthcheckfilesinkv3.HealthCheckEventFileSink{
+				EventLogPath: *hc.EventLogPath,
+			}),
+		}}
+	}
 
 	if hc.Http != nil {
 		httpHealthCheck := &envoycorev3.HealthCheck_HttpHealthCheck{
@@ -30,6 +57,12 @@ func translateHealthCheck(hc *kgateway.HealthCheck) *envoycorev3.HealthCheck {
 		if hc.Http.Method != nil {
 			httpHealthCheck.Method = envoycorev3.RequestMethod(envoycorev3.RequestMethod_value[*hc.Http.Method])
 		}
+		for _, statusRange := range hc.Http.ExpectedStatuses {
+			httpHealthCheck.ExpectedStatuses = append(httpHealthCheck.ExpectedStatuses, &envoytypev3.Int64Range{
+				Start: int64(statusRange.Start),
+				End:   int64(statusRange.End),
+			})
+		}
 		healthCheck.HealthChecker = &envoycorev3.HealthCheck_HttpHealthCheck_{
 			HttpHealthCheck: httpHealthCheck,
 		}
@@ -43,7 +76,54 @@ func translateHealthCheck(hc *kgateway.HealthCheck) *envoycorev3.HealthCheck {
 		if hc.Grpc.Authority != nil {
 			healthCheck.GetGrpcHealthCheck().Authority = *hc.Grpc.Authority
 		}
+	} else if hc.Tcp != nil {
+		tcpHealthCheck := &envoycorev3.HealthCheck_TcpHealthCheck{}
+		if hc.Tcp.Send != nil {
+			tcpHealthCheck.Send = translateHealthCheckPayload(hc.Tcp.Send)
+		}
+		for i := range hc.Tcp.Receive {
+			tcpHealthCheck.Receive = append(tcpHealthCheck.Receive, translateHealthCheckPayload(&hc.Tcp.Receive[i]))
+		}
+		healthCheck.HealthChecker = &envoycorev3.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: tcpHealthCheck,
+		}
 	}
 
 	return healthCheck
 }
+
+// validateHealthCheck validates the translated health check. Envoy accepts status ranges that
+// can never match, so they are rejected here, along with event log paths outside the allowed directory.
+func validateHealthCheck(hc *envoycorev3.HealthCheck) error {
+	if err := hc.Validate(); err != nil {
+		return err
+	}
+	for _, statusRange := range hc.GetHttpHealthCheck().GetExpectedStatuses() {
+		if statusRange.GetStart() >= statusRange.GetEnd() {
+			return fmt.Errorf("healthCheck: expected status range start %d must be lower than end %d", statusRange.GetStart(), statusRange.GetEnd())
+		}
+	}
+	for _, eventLogger := range hc.GetEventLogger() {
+		fileSink := &envoyhealthcheckfilesinkv3.HealthCheckEventFileSink{}
+		if err := eventLogger.GetTypedConfig().UnmarshalTo(fileSink); err != nil {
+			return err
+		}
+		if !healthCheckEventLogPathRegex.MatchString(fileSink.GetEventLogPath()) {
+			return fmt.Errorf("healthCheck: eventLogPath %q must be /dev/stdout or a file in /var/log/envoy", fileSink.GetEventLogPath())
+		}
+	}
+	return nil
+}
+
+// translateHealthCheckPayload converts a health check payload. Envoy expects the text payloads
+// to be hex-encoded, so plain text payloads are sent as binary.
+func translateHealthCheckPayload(p *kgateway.HealthCheckPayload) *envoycorev3.HealthCheck_Payload {
+	if p.Hex != nil {
+		return &envoycorev3.HealthCheck_Payload{
+			Payload: &envoycorev3.HealthCheck_Payload_Text{Text: *p.Hex},
+		}
+	}
+	return &envoycorev3.HealthCheck_Payload{
+		Payload: &envoycorev3.HealthCheck_Payload_Binary{Binary: []byte(ptr.Deref(p.Text, ""))},
+	}
+}
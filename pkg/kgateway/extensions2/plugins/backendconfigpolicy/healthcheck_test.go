@@ -5,6 +5,8 @@ import (
 	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhealthcheckfilesinkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/health_check/event_sinks/file/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -12,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 )
 
 func TestTranslateHealthCheck(t *testing.T) {
@@ -110,6 +113,10 @@ func TestTranslateHealthCheck(t *testing.T) {
 				Http: &kgateway.HealthCheckHttp{
 					Host: ptr.To("example.com"),
 					Path: "/health",
+					ExpectedStatuses: []kgateway.HTTPStatusRange{
+						{Start: 200, End: 300},
+						{Start: 404, End: 405},
+					},
 				},
 			},
 			expected: &envoycorev3.HealthCheck{
@@ -121,10 +128,75 @@ func TestTranslateHealthCheck(t *testing.T) {
 					HttpHealthCheck: &envoycorev3.HealthCheck_HttpHealthCheck{
 						Host: "example.com",
 						Path: "/health",
+						ExpectedStatuses: []*envoytypev3.Int64Range{
+							{Start: 200, End: 300},
+							{Start: 404, End: 405},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TCP health check with payloads",
+			config: &kgateway.HealthCheck{
+				Timeout:               metav1.Duration{Duration: time.Second},
+				Interval:              metav1.Duration{Duration: 5 * time.Second},
+				UnhealthyThreshold:    2,
+				HealthyThreshold:      1,
+				NoTrafficInterval:     &metav1.Duration{Duration: 30 * time.Second},
+				UnhealthyEdgeInterval: &metav1.Duration{Duration: time.Second},
+				EventLogPath:          ptr.To("/dev/stdout"),
+				Tcp: &kgateway.HealthCheckTcp{
+					Send: &kgateway.HealthCheckPayload{Text: ptr.To("PING\r\n")},
+					Receive: []kgateway.HealthCheckPayload{
+						{Hex: ptr.To("2B504F4E47")},
+					},
+				},
+			},
+			expected: &envoycorev3.HealthCheck{
+				Timeout:               durationpb.New(time.Second),
+				Interval:              durationpb.New(5 * time.Second),
+				UnhealthyThreshold:    &wrapperspb.UInt32Value{Value: 2},
+				HealthyThreshold:      &wrapperspb.UInt32Value{Value: 1},
+				NoTrafficInterval:     durationpb.New(30 * time.Second),
+				UnhealthyEdgeInterval: durationpb.New(time.Second),
+				EventLogger: []*envoycorev3.TypedExtensionConfig{{
+					Name: healthCheckEventFileSinkName,
+					TypedConfig: utils.MustMessageToAny(&envoyhealthcheckfilesinkv3.HealthCheckEventFileSink{
+						EventLogPath: "/dev/stdout",
+					}),
+				}},
+				HealthChecker: &envoycorev3.HealthCheck_TcpHealthCheck_{
+					TcpHealthCheck: &envoycorev3.HealthCheck_TcpHealthCheck{
+						Send: &envoycorev3.HealthCheck_Payload{
+							Payload: &envoycorev3.HealthCheck_Payload_Binary{Binary: []byte("PING\r\n")},
+						},
+						Receive: []*envoycorev3.HealthCheck_Payload{{
+							Payload: &envoycorev3.HealthCheck_Payload_Text{Text: "2B504F4E47"},
+						}},
 					},
 				},
 			},
 		},
+		{
+			name: "TCP connect-only health check",
+			config: &kgateway.HealthCheck{
+				Timeout:            metav1.Duration{Duration: time.Second},
+				Interval:           metav1.Duration{Duration: 5 * time.Second},
+				UnhealthyThreshold: 2,
+				HealthyThreshold:   1,
+				Tcp:                &kgateway.HealthCheckTcp{},
+			},
+			expected: &envoycorev3.HealthCheck{
+				Timeout:            durationpb.New(time.Second),
+				Interval:           durationpb.New(5 * time.Second),
+				UnhealthyThreshold: &wrapperspb.UInt32Value{Value: 2},
+				HealthyThreshold:   &wrapperspb.UInt32Value{Value: 1},
+				HealthChecker: &envoycorev3.HealthCheck_TcpHealthCheck_{
+					TcpHealthCheck: &envoycorev3.HealthCheck_TcpHealthCheck{},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -136,3 +208,70 @@ func TestTranslateHealthCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHealthCheck(t *testing.T) {
+	base := func(mutate func(*kgateway.HealthCheck)) *kgateway.HealthCheck {
+		hc := &kgateway.HealthCheck{
+			Timeout:            metav1.Duration{Duration: time.Second},
+			Interval:           metav1.Duration{Duration: 5 * time.Second},
+			UnhealthyThreshold: 2,
+			HealthyThreshold:   1,
+			Http:               &kgateway.HealthCheckHttp{Path: "/health"},
+		}
+		mutate(hc)
+		return hc
+	}
+
+	tests := []struct {
+		name      string
+		config    *kgateway.HealthCheck
+		expectErr bool
+	}{
+		{
+			name: "valid status range and event log path",
+			config: base(func(hc *kgateway.HealthCheck) {
+				hc.Http.ExpectedStatuses = []kgateway.HTTPStatusRange{{Start: 200, End: 300}}
+				hc.EventLogPath = ptr.To("/var/log/envoy/health_check.log")
+			}),
+		},
+		{
+			name: "event log path to stdout",
+			config: base(func(hc *kgateway.HealthCheck) {
+				hc.EventLogPath = ptr.To("/dev/stdout")
+			}),
+		},
+		{
+			name: "empty status range",
+			config: base(func(hc *kgateway.HealthCheck) {
+				hc.Http.ExpectedStatuses = []kgateway.HTTPStatusRange{{Start: 200, End: 300}, {Start: 404, End: 404}}
+			}),
+			expectErr: true,
+		},
+		{
+			name: "event log path outside the log directory",
+			config: base(func(hc *kgateway.HealthCheck) {
+				hc.EventLogPath = ptr.To("/etc/envoy/envoy.yaml")
+			}),
+			expectErr: true,
+		},
+		{
+			name: "event log path escaping the log directory",
+			config: base(func(hc *kgateway.HealthCheck) {
+				hc.EventLogPath = ptr.To("/var/log/envoy/../../../etc/passwd")
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateHealthCheck(translateHealthCheck(test.config))
+			if test.expectErr && err == nil {
+				t.Errorf("expected an error")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycswrrv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/client_side_weighted_round_robin/v3"
 	envoycommonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/common/v3"
 	envoyleastrequestv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/least_request/v3"
 	envoymaglevv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/maglev/v3"
@@ -187,6 +188,23 @@ func translateLoadBalancerConfig(config *kgateway.LoadBalancer, policyName, poli
 				},
 			}},
 		}
+	} else if config.ClientSideWeightedRoundRobin != nil {
+		cswrr, err := toClientSideWeightedRoundRobin(config.ClientSideWeightedRoundRobin, policyName, policyNamespace)
+		if err != nil {
+			return nil, err
+		}
+		cswrrAny, err := utils.MessageToAny(cswrr)
+		if err != nil {
+			return nil, err
+		}
+		out.loadBalancingPolicy = &envoyclusterv3.LoadBalancingPolicy{
+			Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+				TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+					Name:        "envoy.load_balancing_policies.client_side_weighted_round_robin",
+					TypedConfig: cswrrAny,
+				},
+			}},
+		}
 	}
 
 	return out, nil
@@ -278,6 +296,33 @@ func toSlowStartConfig(cfg *kgateway.SlowStart, name, namespace string) *envoyco
 	return out
 }
 
+func toClientSideWeightedRoundRobin(
+	cfg *kgateway.LoadBalancerClientSideWeightedRoundRobinConfig,
+	name, namespace string,
+) (*envoycswrrv3.ClientSideWeightedRoundRobin, error) {
+	out := &envoycswrrv3.ClientSideWeightedRoundRobin{
+		MetricNamesForComputingUtilization: cfg.MetricNamesForComputingUtilization,
+		SlowStartConfig:                    toSlowStartConfig(cfg.SlowStart, name, namespace),
+	}
+	if cfg.BlackoutPeriod != nil {
+		out.BlackoutPeriod = durationpb.New(cfg.BlackoutPeriod.Duration)
+	}
+	if cfg.WeightExpirationPeriod != nil {
+		out.WeightExpirationPeriod = durationpb.New(cfg.WeightExpirationPeriod.Duration)
+	}
+	if cfg.WeightUpdatePeriod != nil {
+		out.WeightUpdatePeriod = durationpb.New(cfg.WeightUpdatePeriod.Duration)
+	}
+	if cfg.ErrorUtilizationPenalty != nil {
+		penalty, err := strconv.ParseFloat(*cfg.ErrorUtilizationPenalty, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clientSideWeightedRoundRobin.errorUtilizationPenalty %q: %w", *cfg.ErrorUtilizationPenalty, err)
+		}
+		out.ErrorUtilizationPenalty = wrapperspb.Float(float32(penalty))
+	}
+	return out, nil
+}
+
 func (a *LoadBalancerConfigIR) Equals(b *LoadBalancerConfigIR) bool {
 	if a == nil && b == nil {
 		return true
@@ -7,6 +7,7 @@ import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	cswrrv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/client_side_weighted_round_robin/v3"
 	envoycommonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/common/v3"
 	leastrequestv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/least_request/v3"
 	maglevv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/maglev/v3"
@@ -373,6 +374,45 @@ func TestApplyLoadBalancerConfig(t *testing.T) {
 				}
 			}(),
 		},
+		{
+			name: "ClientSideWeightedRoundRobin full config",
+			config: &kgateway.LoadBalancer{
+				ClientSideWeightedRoundRobin: &kgateway.LoadBalancerClientSideWeightedRoundRobinConfig{
+					BlackoutPeriod:                     &metav1.Duration{Duration: 5 * time.Second},
+					WeightExpirationPeriod:             &metav1.Duration{Duration: time.Minute},
+					WeightUpdatePeriod:                 &metav1.Duration{Duration: 500 * time.Millisecond},
+					ErrorUtilizationPenalty:            ptr.To("1.5"),
+					MetricNamesForComputingUtilization: []string{"named_metrics.queue_depth"},
+					SlowStart: &kgateway.SlowStart{
+						Window: &metav1.Duration{Duration: 10 * time.Second},
+					},
+				},
+			},
+			expected: func() *envoyclusterv3.Cluster {
+				msg, _ := utils.MessageToAny(&cswrrv3.ClientSideWeightedRoundRobin{
+					BlackoutPeriod:                     durationpb.New(5 * time.Second),
+					WeightExpirationPeriod:             durationpb.New(time.Minute),
+					WeightUpdatePeriod:                 durationpb.New(500 * time.Millisecond),
+					ErrorUtilizationPenalty:            wrapperspb.Float(1.5),
+					MetricNamesForComputingUtilization: []string{"named_metrics.queue_depth"},
+					SlowStartConfig: &envoycommonv3.SlowStartConfig{
+						SlowStartWindow: durationpb.New(10 * time.Second),
+					},
+				})
+				return &envoyclusterv3.Cluster{
+					Name: "test",
+					LoadBalancingPolicy: &envoyclusterv3.LoadBalancingPolicy{
+						Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+							TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+								Name:        "envoy.load_balancing_policies.client_side_weighted_round_robin",
+								TypedConfig: msg,
+							},
+						}},
+					},
+					CommonLbConfig: &envoyclusterv3.Cluster_CommonLbConfig{},
+				}
+			}(),
+		},
 		{
 			name: "CloseConnectionsOnHostSetChange",
 			config: &kgateway.LoadBalancer{
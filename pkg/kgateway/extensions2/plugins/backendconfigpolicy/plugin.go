@@ -45,6 +45,9 @@ type BackendConfigPolicyIR struct {
 	healthCheck                   *envoycorev3.HealthCheck
 	outlierDetection              *envoyclusterv3.OutlierDetection
 	circuitBreakers               *envoyclusterv3.CircuitBreakers
+	proxyProtocol                 *envoycorev3.ProxyProtocolConfig
+	httpConnectProxyAddress       *envoycorev3.Address
+	dns                           *DnsConfigIR
 }
 
 var logger = logging.New("plugin/backendconfigpolicy")
@@ -111,6 +114,18 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if !proto.Equal(d.proxyProtocol, d2.proxyProtocol) {
+		return false
+	}
+
+	if !proto.Equal(d.httpConnectProxyAddress, d2.httpConnectProxyAddress) {
+		return false
+	}
+
+	if !d.dns.Equals(d2.dns) {
+		return false
+	}
+
 	return true
 }
 
@@ -234,6 +249,11 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 	if pol.circuitBreakers != nil {
 		out.CircuitBreakers = pol.circuitBreakers
 	}
+
+	applyUpstreamTransport(pol, out)
+
+	// applied last, since it changes the discovery type of the cluster.
+	applyDnsConfig(pol.dns, out)
 }
 
 func translate(
@@ -291,6 +311,9 @@ func translate(
 
 	if pol.Spec.HealthCheck != nil {
 		ir.healthCheck = translateHealthCheck(pol.Spec.HealthCheck)
+		if err := validateHealthCheck(ir.healthCheck); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if pol.Spec.OutlierDetection != nil {
@@ -304,6 +327,26 @@ func translate(
 		}
 	}
 
+	if pol.Spec.ProxyProtocol != nil {
+		ir.proxyProtocol = translateProxyProtocol(pol.Spec.ProxyProtocol)
+	}
+
+	if pol.Spec.HTTPConnectProxy != nil {
+		if err := validateHTTPConnectProxyTargets(pol); err != nil {
+			errs = append(errs, err)
+		} else {
+			proxyAddress, err := translateHTTPConnectProxy(commoncol.BackendIndex, krtctx, pol)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			ir.httpConnectProxyAddress = proxyAddress
+		}
+	}
+
+	if pol.Spec.Dns != nil {
+		ir.dns = translateDnsConfig(pol.Spec.Dns)
+	}
+
 	return &ir, errs
 }
 
@@ -9,6 +9,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
 	envoy_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -281,6 +282,32 @@ func TestBackendConfigPolicyTranslation(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "circuit breakers retry budget",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					CircuitBreakers: &kgateway.CircuitBreakers{
+						RetryBudget: &kgateway.RetryBudget{
+							BudgetPercent:       ptr.To(int32(25)),
+							MinRetryConcurrency: ptr.To(int32(5)),
+						},
+					},
+				},
+			},
+			want: &envoyclusterv3.Cluster{
+				CircuitBreakers: &envoyclusterv3.CircuitBreakers{
+					Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{
+						{
+							RetryBudget: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+								BudgetPercent:       &envoytypev3.Percent{Value: 25},
+								MinRetryConcurrency: &wrapperspb.UInt32Value{Value: 5},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,164 @@
+package backendconfigpolicy
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhttp11proxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/http_11_proxy/v3"
+	envoyproxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	upstreamProxyProtocolTransportSocketName = "envoy.transport_sockets.upstream_proxy_protocol"
+	http11ProxyTransportSocketName           = "envoy.transport_sockets.http_11_proxy"
+	// http11ProxyAddressMetadataKey is the endpoint typed metadata key holding the address of the
+	// proxy used by the HTTP/1.1 proxy transport socket.
+	http11ProxyAddressMetadataKey = "envoy.http11_proxy_transport_socket.proxy_address"
+)
+
+func translateProxyProtocol(in *kgateway.UpstreamProxyProtocol) *envoycorev3.ProxyProtocolConfig {
+	out := &envoycorev3.ProxyProtocolConfig{
+		Version: envoycorev3.ProxyProtocolConfig_V1,
+	}
+	if in.Version == kgateway.ProxyProtocolVersionV2 {
+		out.Version = envoycorev3.ProxyProtocolConfig_V2
+	}
+	for _, tlv := range in.AddedTLVs {
+		out.AddedTlvs = append(out.AddedTlvs, &envoycorev3.TlvEntry{
+			Type:  uint32(tlv.Type), // nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 255
+			Value: []byte(tlv.Value),
+		})
+	}
+	if in.PassThroughTLVs != nil {
+		passThrough := &envoycorev3.ProxyProtocolPassThroughTLVs{
+			MatchType: envoycorev3.ProxyProtocolPassThroughTLVs_INCLUDE_ALL,
+		}
+		if len(in.PassThroughTLVs.Types) > 0 {
+			passThrough.MatchType = envoycorev3.ProxyProtocolPassThroughTLVs_INCLUDE
+			for _, t := range in.PassThroughTLVs.Types {
+				passThrough.TlvType = append(passThrough.TlvType, uint32(t)) // nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 255
+			}
+		}
+		out.PassThroughTlvs = passThrough
+	}
+	return out
+}
+
+// validateHTTPConnectProxyTargets returns an error if the policy targets a Kubernetes Service.
+// The endpoints of Services are discovered with EDS, so the proxy address cannot be added to
+// the endpoint metadata.
+func validateHTTPConnectProxyTargets(pol *kgateway.BackendConfigPolicy) error {
+	for _, ref := range pol.Spec.TargetRefs {
+		if ref.Kind == wellknown.ServiceKind {
+			return fmt.Errorf("httpConnectProxy: cannot be used when targeting Service %s, only Backend resources are supported", ref.Name)
+		}
+	}
+	for _, sel := range pol.Spec.TargetSelectors {
+		if sel.Kind == wellknown.ServiceKind {
+			return errors.New("httpConnectProxy: cannot be used when selecting Services, only Backend resources are supported")
+		}
+	}
+	return nil
+}
+
+// translateHTTPConnectProxy resolves the address of the HTTP CONNECT proxy referenced by the policy.
+// Envoy requires the proxy address to be an IP address, so the cluster IP of the Service is used.
+func translateHTTPConnectProxy(
+	backends *krtcollections.BackendIndex,
+	krtctx krt.HandlerContext,
+	pol *kgateway.BackendConfigPolicy,
+) (*envoycorev3.Address, error) {
+	ref := pol.Spec.HTTPConnectProxy.BackendRef
+	if ref.Port == nil {
+		return nil, errors.New("httpConnectProxy: backendRef port is required")
+	}
+	gk := wellknown.BackendConfigPolicyGVK.GroupKind()
+	src := ir.ObjectSource{
+		Group:     gk.Group,
+		Kind:      gk.Kind,
+		Namespace: pol.Namespace,
+		Name:      pol.Name,
+	}
+	backend, err := backends.GetBackendFromRef(krtctx, src, ref)
+	if err != nil {
+		return nil, fmt.Errorf("httpConnectProxy: failed to resolve backendRef: %w", err)
+	}
+	svc, ok := backend.Obj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("httpConnectProxy: backendRef %s must reference a Kubernetes Service", backend.ResourceName())
+	}
+	clusterIP, err := netip.ParseAddr(svc.Spec.ClusterIP)
+	if err != nil {
+		return nil, fmt.Errorf("httpConnectProxy: Service %s/%s does not have a cluster IP", svc.Namespace, svc.Name)
+	}
+	return &envoycorev3.Address{
+		Address: &envoycorev3.Address_SocketAddress{
+			SocketAddress: &envoycorev3.SocketAddress{
+				Address: clusterIP.String(),
+				PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+					PortValue: uint32(*ref.Port), // nolint:gosec // G115: Gateway API validation ensures 1 <= port <= 65535
+				},
+			},
+		},
+	}, nil
+}
+
+// applyUpstreamTransport wraps the transport socket of the cluster with the PROXY protocol and
+// HTTP/1.1 proxy transport sockets. The HTTP/1.1 proxy transport socket is the outermost, since the
+// tunnel through the proxy must be established before the PROXY protocol header and TLS are sent.
+func applyUpstreamTransport(pol *BackendConfigPolicyIR, out *envoyclusterv3.Cluster) {
+	if pol.proxyProtocol != nil {
+		out.TransportSocket = &envoycorev3.TransportSocket{
+			Name: upstreamProxyProtocolTransportSocketName,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&envoyproxyprotocolv3.ProxyProtocolUpstreamTransport{
+					Config:          pol.proxyProtocol,
+					TransportSocket: out.GetTransportSocket(),
+				}),
+			},
+		}
+	}
+
+	if pol.httpConnectProxyAddress == nil {
+		return
+	}
+	// The proxy address is read from the endpoint metadata, so only the clusters with
+	// inline endpoints can be tunneled.
+	if out.GetLoadAssignment() == nil {
+		if out.GetType() == envoyclusterv3.Cluster_EDS {
+			logger.Error("httpConnectProxy is only supported for backends with static endpoints. Ignoring httpConnectProxy.",
+				"cluster", out.GetName())
+		}
+		return
+	}
+	out.TransportSocket = &envoycorev3.TransportSocket{
+		Name: http11ProxyTransportSocketName,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: utils.MustMessageToAny(&envoyhttp11proxyv3.Http11ProxyUpstreamTransport{
+				TransportSocket: out.GetTransportSocket(),
+			}),
+		},
+	}
+	proxyAddress := utils.MustMessageToAny(pol.httpConnectProxyAddress)
+	for _, localityEndpoints := range out.GetLoadAssignment().GetEndpoints() {
+		if localityEndpoints.Metadata == nil {
+			localityEndpoints.Metadata = &envoycorev3.Metadata{}
+		}
+		if localityEndpoints.Metadata.TypedFilterMetadata == nil {
+			localityEndpoints.Metadata.TypedFilterMetadata = map[string]*anypb.Any{}
+		}
+		localityEndpoints.Metadata.TypedFilterMetadata[http11ProxyAddressMetadataKey] = proxyAddress
+	}
+}
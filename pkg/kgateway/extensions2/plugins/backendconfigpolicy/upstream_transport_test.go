@@ -0,0 +1,171 @@
+package backendconfigpolicy
+
+import (
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyhttp11proxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/http_11_proxy/v3"
+	envoyproxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func TestTranslateProxyProtocol(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *kgateway.UpstreamProxyProtocol
+		expected *envoycorev3.ProxyProtocolConfig
+	}{
+		{
+			name:   "version 1",
+			config: &kgateway.UpstreamProxyProtocol{Version: kgateway.ProxyProtocolVersionV1},
+			expected: &envoycorev3.ProxyProtocolConfig{
+				Version: envoycorev3.ProxyProtocolConfig_V1,
+			},
+		},
+		{
+			name: "version 2 with TLVs",
+			config: &kgateway.UpstreamProxyProtocol{
+				Version:         kgateway.ProxyProtocolVersionV2,
+				AddedTLVs:       []kgateway.ProxyProtocolTLV{{Type: 0xE0, Value: "tenant-a"}},
+				PassThroughTLVs: &kgateway.ProxyProtocolPassThroughTLVs{Types: []int32{0x01, 0x02}},
+			},
+			expected: &envoycorev3.ProxyProtocolConfig{
+				Version:   envoycorev3.ProxyProtocolConfig_V2,
+				AddedTlvs: []*envoycorev3.TlvEntry{{Type: 0xE0, Value: []byte("tenant-a")}},
+				PassThroughTlvs: &envoycorev3.ProxyProtocolPassThroughTLVs{
+					MatchType: envoycorev3.ProxyProtocolPassThroughTLVs_INCLUDE,
+					TlvType:   []uint32{0x01, 0x02},
+				},
+			},
+		},
+		{
+			name: "version 2 passing through all TLVs",
+			config: &kgateway.UpstreamProxyProtocol{
+				Version:         kgateway.ProxyProtocolVersionV2,
+				PassThroughTLVs: &kgateway.ProxyProtocolPassThroughTLVs{},
+			},
+			expected: &envoycorev3.ProxyProtocolConfig{
+				Version: envoycorev3.ProxyProtocolConfig_V2,
+				PassThroughTlvs: &envoycorev3.ProxyProtocolPassThroughTLVs{
+					MatchType: envoycorev3.ProxyProtocolPassThroughTLVs_INCLUDE_ALL,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := translateProxyProtocol(test.config)
+			assert.True(t, proto.Equal(test.expected, result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestApplyUpstreamTransport(t *testing.T) {
+	tlsSocket := &envoycorev3.TransportSocket{
+		Name: envoywellknown.TransportSocketTls,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: utils.MustMessageToAny(&envoytlsv3.UpstreamTlsContext{Sni: "example.com"}),
+		},
+	}
+	proxyProtocol := &envoycorev3.ProxyProtocolConfig{Version: envoycorev3.ProxyProtocolConfig_V2}
+	proxyAddress := &envoycorev3.Address{
+		Address: &envoycorev3.Address_SocketAddress{
+			SocketAddress: &envoycorev3.SocketAddress{
+				Address:       "10.0.0.10",
+				PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: 3128},
+			},
+		},
+	}
+
+	t.Run("transport sockets are nested around the existing transport socket", func(t *testing.T) {
+		out := &envoyclusterv3.Cluster{
+			Name:                 "test",
+			ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+			TransportSocket:      tlsSocket,
+			LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+				Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{}},
+			},
+		}
+		applyUpstreamTransport(&BackendConfigPolicyIR{
+			proxyProtocol:           proxyProtocol,
+			httpConnectProxyAddress: proxyAddress,
+		}, out)
+
+		assert.Equal(t, http11ProxyTransportSocketName, out.GetTransportSocket().GetName())
+		http11Proxy := &envoyhttp11proxyv3.Http11ProxyUpstreamTransport{}
+		require.NoError(t, out.GetTransportSocket().GetTypedConfig().UnmarshalTo(http11Proxy))
+
+		assert.Equal(t, upstreamProxyProtocolTransportSocketName, http11Proxy.GetTransportSocket().GetName())
+		upstreamProxyProtocol := &envoyproxyprotocolv3.ProxyProtocolUpstreamTransport{}
+		require.NoError(t, http11Proxy.GetTransportSocket().GetTypedConfig().UnmarshalTo(upstreamProxyProtocol))
+		assert.True(t, proto.Equal(proxyProtocol, upstreamProxyProtocol.GetConfig()))
+		assert.True(t, proto.Equal(tlsSocket, upstreamProxyProtocol.GetTransportSocket()))
+
+		metadata := out.GetLoadAssignment().GetEndpoints()[0].GetMetadata().GetTypedFilterMetadata()[http11ProxyAddressMetadataKey]
+		address := &envoycorev3.Address{}
+		require.NoError(t, metadata.UnmarshalTo(address))
+		assert.True(t, proto.Equal(proxyAddress, address))
+	})
+
+	t.Run("http connect proxy is ignored for EDS clusters", func(t *testing.T) {
+		out := &envoyclusterv3.Cluster{
+			Name:                 "test",
+			ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_EDS},
+		}
+		applyUpstreamTransport(&BackendConfigPolicyIR{httpConnectProxyAddress: proxyAddress}, out)
+		assert.Nil(t, out.GetTransportSocket())
+	})
+}
+
+func TestValidateHTTPConnectProxyTargets(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      kgateway.BackendConfigPolicySpec
+		expectErr bool
+	}{
+		{
+			name: "backend target",
+			spec: kgateway.BackendConfigPolicySpec{
+				TargetRefs: []shared.LocalPolicyTargetReference{{Group: "gateway.kgateway.dev", Kind: "Backend", Name: "static"}},
+			},
+		},
+		{
+			name: "service target",
+			spec: kgateway.BackendConfigPolicySpec{
+				TargetRefs: []shared.LocalPolicyTargetReference{
+					{Group: "gateway.kgateway.dev", Kind: "Backend", Name: "static"},
+					{Group: "", Kind: "Service", Name: "svc"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "service selector",
+			spec: kgateway.BackendConfigPolicySpec{
+				TargetSelectors: []shared.LocalPolicyTargetSelector{{Group: "", Kind: "Service"}},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHTTPConnectProxyTargets(&kgateway.BackendConfigPolicy{Spec: tt.spec})
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
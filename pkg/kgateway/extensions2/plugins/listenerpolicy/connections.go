@@ -0,0 +1,178 @@
+package listenerpolicy
+
+import (
+	"errors"
+	"slices"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	ratelimitconfv3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	connection_limitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	local_ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	network_ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/ratelimit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/ratelimit"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	kgwwellknown "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const (
+	connectionLimitFilterName           = "envoy.filters.network.connection_limit"
+	connectionRateLimitFilterName       = "envoy.filters.network.local_ratelimit"
+	connectionSourceRateLimitFilterName = "envoy.filters.network.ratelimit"
+
+	// connectionRateLimitDomain is the domain of the per-source connection rate limits in the built-in rate limit service
+	connectionRateLimitDomain = "kgateway-connections"
+	// sourceAddressDescriptorKey is the key of the descriptor entry holding the client address,
+	// so that the built-in rate limit service counts the connections of each client separately
+	sourceAddressDescriptorKey = "remote_address"
+)
+
+// connectionLimits are the network filters limiting the connections of the listener on a port.
+// They are added in front of the network filters of each filter chain once the listener is translated.
+type connectionLimits struct {
+	connectionLimit *connection_limitv3.ConnectionLimit
+	rateLimit       *local_ratelimitv3.LocalRateLimit
+	sourceRateLimit *network_ratelimitv3.RateLimit
+}
+
+func (c *connectionLimits) Equals(other *connectionLimits) bool {
+	return cmputils.CompareWithNils(c, other, func(a, b *connectionLimits) bool {
+		return proto.Equal(a.connectionLimit, b.connectionLimit) &&
+			proto.Equal(a.rateLimit, b.rateLimit) &&
+			proto.Equal(a.sourceRateLimit, b.sourceRateLimit)
+	})
+}
+
+// convertConnectionLimits converts the connection limits of the listener configuration of a ListenerPolicy
+// for the given port, or of its default listener configuration if the port is 0.
+func convertConnectionLimits(
+	in *kgateway.ConnectionLimits,
+	objSrc ir.ObjectSource,
+	port int32,
+	builtinRateLimit bool,
+) (*connectionLimits, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &connectionLimits{}
+	if in.MaxConnections != nil {
+		out.connectionLimit = &connection_limitv3.ConnectionLimit{
+			StatPrefix:     "connection_limit",
+			MaxConnections: wrapperspb.UInt64(uint64(*in.MaxConnections)), //nolint:gosec // G115: kubebuilder validation ensures the value is at least 1
+		}
+		if in.Delay != nil {
+			out.connectionLimit.Delay = durationpb.New(in.Delay.Duration)
+		}
+	}
+	if in.RateLimit != nil {
+		out.rateLimit = &local_ratelimitv3.LocalRateLimit{
+			StatPrefix: "connection_rate_limit",
+			TokenBucket: &typev3.TokenBucket{
+				MaxTokens:     uint32(in.RateLimit.MaxTokens),                                      //nolint:gosec // G115: kubebuilder validation ensures the value is at least 1
+				TokensPerFill: wrapperspb.UInt32(uint32(ptr.Deref(in.RateLimit.TokensPerFill, 1))), //nolint:gosec // G115: kubebuilder validation ensures the value is at least 1
+				FillInterval:  durationpb.New(in.RateLimit.FillInterval.Duration),
+			},
+		}
+	}
+	if in.PerSourceRateLimit != nil {
+		if !builtinRateLimit {
+			return nil, errors.New("connections: perSourceRateLimit requires the built-in rate limit service, which is not enabled")
+		}
+		out.sourceRateLimit = &network_ratelimitv3.RateLimit{
+			StatPrefix: "connection_source_rate_limit",
+			Domain:     connectionRateLimitDomain,
+			Descriptors: []*ratelimitv3.RateLimitDescriptor{{
+				Entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+					{Key: ratelimit.DescriptorIDKey, Value: ratelimit.ConnectionDescriptorID(objSrc.Namespace, objSrc.Name, port)},
+					{Key: sourceAddressDescriptorKey, Value: "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%"},
+				},
+			}},
+			RateLimitService: &ratelimitconfv3.RateLimitServiceConfig{
+				GrpcService: &envoycorev3.GrpcService{
+					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
+							ClusterName: kgwwellknown.XdsClusterName,
+						},
+					},
+				},
+				TransportApiVersion: envoycorev3.ApiVersion_V3,
+			},
+		}
+	}
+	return out, nil
+}
+
+// applyToListener adds the connection limit filters to the network filters of all filter chains, right after
+// the network RBAC filters of the chain if any, so that connections denied by the networkAuthorization of a
+// TrafficPolicy don't consume tokens or call the rate limit service, and in front of the other filters otherwise.
+// The rate limits are shared by the filter chains of the listener, while the connection limit applies to
+// each filter chain, i.e. to each Gateway listener on the port.
+// The per-source rate limit comes first, so that the connections of a client over its limit don't consume
+// the tokens shared by all clients.
+func (c *connectionLimits) applyToListener(out *envoylistenerv3.Listener) error {
+	var limitFilters []*envoylistenerv3.Filter
+	if c.sourceRateLimit != nil {
+		filter, err := newNetworkFilter(connectionSourceRateLimitFilterName, c.sourceRateLimit)
+		if err != nil {
+			return err
+		}
+		limitFilters = append(limitFilters, filter)
+	}
+	if c.rateLimit != nil {
+		rateLimit := proto.Clone(c.rateLimit).(*local_ratelimitv3.LocalRateLimit)
+		rateLimit.ShareKey = out.GetName()
+		filter, err := newNetworkFilter(connectionRateLimitFilterName, rateLimit)
+		if err != nil {
+			return err
+		}
+		limitFilters = append(limitFilters, filter)
+	}
+	if c.connectionLimit != nil {
+		filter, err := newNetworkFilter(connectionLimitFilterName, c.connectionLimit)
+		if err != nil {
+			return err
+		}
+		limitFilters = append(limitFilters, filter)
+	}
+
+	for _, fc := range out.GetFilterChains() {
+		i := rbacFiltersEnd(fc.GetFilters())
+		fc.Filters = slices.Concat(fc.GetFilters()[:i], limitFilters, fc.GetFilters()[i:])
+	}
+	return nil
+}
+
+// rbacFiltersEnd returns the index after the last network RBAC filter, or 0 if there is none.
+func rbacFiltersEnd(filters []*envoylistenerv3.Filter) int {
+	for i := len(filters) - 1; i >= 0; i-- {
+		if filters[i].GetName() == wellknown.RoleBasedAccessControl {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func newNetworkFilter(name string, config proto.Message) (*envoylistenerv3.Filter, error) {
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoylistenerv3.Filter{
+		Name: name,
+		ConfigType: &envoylistenerv3.Filter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
@@ -0,0 +1,84 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	local_ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	network_ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/ratelimit/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/ratelimit"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyConnectionLimitsToListener(t *testing.T) {
+	listener := &envoylistenerv3.Listener{
+		Name: "listener~5432",
+		FilterChains: []*envoylistenerv3.FilterChain{
+			{Name: "a", Filters: []*envoylistenerv3.Filter{{Name: envoywellknown.RoleBasedAccessControl}, {Name: "envoy.filters.network.tcp_proxy"}}},
+			{Name: "b", Filters: []*envoylistenerv3.Filter{{Name: "envoy.filters.network.http_connection_manager"}}},
+		},
+	}
+
+	objSrc := ir.ObjectSource{Namespace: "default", Name: "db"}
+	spec := &kgateway.ConnectionLimits{
+		MaxConnections: ptr.To[int32](50),
+		RateLimit: &kgateway.TokenBucket{
+			MaxTokens:    5,
+			FillInterval: metav1.Duration{Duration: time.Second},
+		},
+		PerSourceRateLimit: &kgateway.RateLimitDescriptorLimit{
+			Requests: 10,
+			Unit:     kgateway.RateLimitUnitMinute,
+		},
+	}
+	limits, err := convertConnectionLimits(spec, objSrc, 5432, true)
+	require.NoError(t, err)
+	require.NoError(t, limits.applyToListener(listener))
+
+	// the limits come after the network authorization, so that denied connections aren't counted
+	assert.Equal(t, envoywellknown.RoleBasedAccessControl, listener.GetFilterChains()[0].GetFilters()[0].GetName())
+	for _, fc := range listener.GetFilterChains() {
+		filters := fc.GetFilters()
+		if filters[0].GetName() == envoywellknown.RoleBasedAccessControl {
+			filters = filters[1:]
+		}
+		require.Len(t, filters, 4)
+		assert.Equal(t, connectionSourceRateLimitFilterName, filters[0].GetName())
+		assert.Equal(t, connectionRateLimitFilterName, filters[1].GetName())
+		assert.Equal(t, connectionLimitFilterName, filters[2].GetName())
+
+		// each client address is counted separately by the built-in rate limit service
+		sourceRateLimit := &network_ratelimitv3.RateLimit{}
+		require.NoError(t, filters[0].GetTypedConfig().UnmarshalTo(sourceRateLimit))
+		assert.Equal(t, wellknown.XdsClusterName, sourceRateLimit.GetRateLimitService().GetGrpcService().GetEnvoyGrpc().GetClusterName())
+		require.Len(t, sourceRateLimit.GetDescriptors(), 1)
+		entries := sourceRateLimit.GetDescriptors()[0].GetEntries()
+		require.Len(t, entries, 2)
+		assert.Equal(t, ratelimit.DescriptorIDKey, entries[0].GetKey())
+		assert.Equal(t, "ListenerPolicy/default/db/5432", entries[0].GetValue())
+		assert.Equal(t, "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%", entries[1].GetValue())
+
+		rateLimit := &local_ratelimitv3.LocalRateLimit{}
+		require.NoError(t, filters[1].GetTypedConfig().UnmarshalTo(rateLimit))
+		// the token bucket is shared by the filter chains of the listener
+		assert.Equal(t, "listener~5432", rateLimit.GetShareKey())
+		assert.Equal(t, uint32(1), rateLimit.GetTokenBucket().GetTokensPerFill().GetValue())
+	}
+	assert.Equal(t, "envoy.filters.network.tcp_proxy", listener.GetFilterChains()[0].GetFilters()[4].GetName())
+
+	_, err = convertConnectionLimits(spec, objSrc, 5432, false)
+	assert.ErrorContains(t, err, "requires the built-in rate limit service")
+
+	limits, err = convertConnectionLimits(nil, objSrc, 0, true)
+	require.NoError(t, err)
+	assert.Nil(t, limits)
+}
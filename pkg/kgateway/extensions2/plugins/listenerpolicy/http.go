@@ -3,13 +3,19 @@ package listenerpolicy
 import (
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoytracev3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	geoipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/geoip/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
 	healthcheckv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	geoipcommonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/geoip_providers/common/v3"
+	maxmindv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/geoip_providers/maxmind/v3"
 	envoy_header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/early_header_mutation/header_mutation/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/proto"
@@ -50,6 +56,8 @@ type HttpListenerPolicyIr struct {
 	acceptHttp10                  *bool
 	defaultHostForHttp10          *string
 	earlyHeaderMutationExtensions []*envoycorev3.TypedExtensionConfig
+	geoIP                         *geoipv3.Geoip
+	geoIPHeaders                  *header_mutationv3.HeaderMutation
 }
 
 func (d *HttpListenerPolicyIr) Equals(in any) bool {
@@ -143,6 +151,14 @@ func (d *HttpListenerPolicyIr) Equals(in any) bool {
 	}) {
 		return false
 	}
+
+	if !proto.Equal(d.geoIP, d2.geoIP) {
+		return false
+	}
+
+	if !proto.Equal(d.geoIPHeaders, d2.geoIPHeaders) {
+		return false
+	}
 	return true
 }
 
@@ -201,6 +217,8 @@ func NewHttpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.Com
 		acceptHttp10:                  h.AcceptHttp10,
 		defaultHostForHttp10:          h.DefaultHostForHttp10,
 		earlyHeaderMutationExtensions: convertHeaderMutations(h.EarlyRequestHeaderModifier),
+		geoIP:                         convertGeoIP(h.GeoIP),
+		geoIPHeaders:                  geoIPHeadersFilter(h.GeoIP),
 	}, errs
 }
 
@@ -272,3 +290,51 @@ func convertHeaderMutations(spec *gwv1.HTTPHeaderFilter) []*envoycorev3.TypedExt
 		TypedConfig: utils.MustMessageToAny(policy),
 	}}
 }
+
+func convertGeoIP(spec *kgateway.GeoIP) *geoipv3.Geoip {
+	if spec == nil {
+		return nil
+	}
+
+	provider := &maxmindv3.MaxMindConfig{
+		CityDbPath: ptr.Deref(spec.MaxMind.CityDBPath, ""),
+		AsnDbPath:  ptr.Deref(spec.MaxMind.ASNDBPath, ""),
+		CommonProviderConfig: &geoipcommonv3.CommonGeoipProviderConfig{
+			GeoHeadersToAdd: &geoipcommonv3.CommonGeoipProviderConfig_GeolocationHeadersToAdd{
+				Country: string(ptr.Deref(spec.Headers.Country, "")),
+				City:    string(ptr.Deref(spec.Headers.City, "")),
+				Region:  string(ptr.Deref(spec.Headers.Region, "")),
+				Asn:     string(ptr.Deref(spec.Headers.ASN, "")),
+			},
+		},
+	}
+
+	return &geoipv3.Geoip{
+		Provider: &envoycorev3.TypedExtensionConfig{
+			Name:        "envoy.geoip_providers.maxmind",
+			TypedConfig: utils.MustMessageToAny(provider),
+		},
+	}
+}
+
+// geoIPHeadersFilterName is the name of the filter that removes the geolocation headers sent by clients.
+const geoIPHeadersFilterName = "envoy.filters.http.header_mutation/geoip"
+
+// geoIPHeadersFilter returns a filter removing the geolocation headers sent by clients. The geoip filter
+// only writes a header when the lookup of its value succeeds, so the headers are removed before the
+// lookup so that they cannot be spoofed.
+func geoIPHeadersFilter(spec *kgateway.GeoIP) *header_mutationv3.HeaderMutation {
+	if spec == nil {
+		return nil
+	}
+	mutations := &header_mutationv3.Mutations{}
+	for _, header := range []*gwv1.HTTPHeaderName{spec.Headers.Country, spec.Headers.City, spec.Headers.Region, spec.Headers.ASN} {
+		if header == nil {
+			continue
+		}
+		mutations.RequestMutations = append(mutations.RequestMutations, &mutation_rulesv3.HeaderMutation{
+			Action: &mutation_rulesv3.HeaderMutation_Remove{Remove: strings.ToLower(string(*header))},
+		})
+	}
+	return &header_mutationv3.HeaderMutation{Mutations: mutations}
+}
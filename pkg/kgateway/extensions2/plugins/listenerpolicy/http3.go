@@ -0,0 +1,163 @@
+package listenerpolicy
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	quicv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+const (
+	quicTransportSocketName = "envoy.transport_sockets.quic"
+	altSvcFilterName        = "envoy.filters.http.header_mutation/alt_svc"
+
+	defaultAltSvcMaxAge = 24 * time.Hour
+)
+
+type http3Policy struct {
+	altSvcMaxAge time.Duration
+}
+
+func convertHTTP3(spec *kgateway.HTTP3Settings) *http3Policy {
+	if spec == nil {
+		return nil
+	}
+	pol := &http3Policy{
+		altSvcMaxAge: defaultAltSvcMaxAge,
+	}
+	if spec.AltSvcMaxAge != nil {
+		pol.altSvcMaxAge = spec.AltSvcMaxAge.Duration
+	}
+	return pol
+}
+
+// UDPPorts returns the listener ports on which HTTP/3 is enabled
+func (d *ListenerPolicyIR) UDPPorts(ports []int32) []int32 {
+	var udpPorts []int32
+	for _, port := range ports {
+		pol, ok := d.perPortPolicy[uint32(port)] //nolint:gosec // G115: Gateway listener port is int32, always positive, safe to convert to uint32
+		if !ok {
+			pol = d.defaultPolicy
+		}
+		if pol.http3 != nil {
+			udpPorts = append(udpPorts, port)
+		}
+	}
+	return udpPorts
+}
+
+// altSvcFilter returns a filter advertising the HTTP/3 listener of the port in responses.
+func altSvcFilter(port uint32, pol *http3Policy) *header_mutationv3.HeaderMutation {
+	return &header_mutationv3.HeaderMutation{
+		Mutations: &header_mutationv3.Mutations{
+			ResponseMutations: []*mutation_rulesv3.HeaderMutation{{
+				Action: &mutation_rulesv3.HeaderMutation_Append{
+					Append: &envoycorev3.HeaderValueOption{
+						Header: &envoycorev3.HeaderValue{
+							Key:   "alt-svc",
+							Value: fmt.Sprintf(`h3=":%d"; ma=%d`, port, int64(pol.altSvcMaxAge.Seconds())),
+						},
+						AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+					},
+				},
+			}},
+		},
+	}
+}
+
+// quicListener derives an HTTP/3 listener from the filter chains of a TCP listener that terminate TLS.
+// Returns nil if the listener has no such filter chain.
+func quicListener(tcpListener *envoylistenerv3.Listener) (*envoylistenerv3.Listener, error) {
+	var filterChains []*envoylistenerv3.FilterChain
+	for _, fc := range tcpListener.GetFilterChains() {
+		quicFc, err := quicFilterChain(fc)
+		if err != nil {
+			return nil, err
+		}
+		if quicFc != nil {
+			filterChains = append(filterChains, quicFc)
+		}
+	}
+	if len(filterChains) == 0 {
+		return nil, nil
+	}
+
+	address := proto.Clone(tcpListener.GetAddress()).(*envoycorev3.Address)
+	if socketAddress := address.GetSocketAddress(); socketAddress != nil {
+		socketAddress.Protocol = envoycorev3.SocketAddress_UDP
+	}
+
+	return &envoylistenerv3.Listener{
+		Name:                          tcpListener.GetName() + "~quic",
+		Address:                       address,
+		PerConnectionBufferLimitBytes: tcpListener.GetPerConnectionBufferLimitBytes(),
+		UdpListenerConfig: &envoylistenerv3.UdpListenerConfig{
+			QuicOptions: &envoylistenerv3.QuicProtocolOptions{},
+		},
+		FilterChains: filterChains,
+		Metadata:     tcpListener.GetMetadata(),
+	}, nil
+}
+
+// quicFilterChain converts an HTTP filter chain that terminates TLS to HTTP/3.
+// Returns nil for filter chains that do not terminate TLS or are not HTTP.
+func quicFilterChain(fc *envoylistenerv3.FilterChain) (*envoylistenerv3.FilterChain, error) {
+	if fc.GetTransportSocket().GetName() != wellknown.TransportSocketTls {
+		return nil, nil
+	}
+	hcmIdx := slices.IndexFunc(fc.GetFilters(), func(f *envoylistenerv3.Filter) bool {
+		return f.GetName() == wellknown.HTTPConnectionManager
+	})
+	if hcmIdx == -1 {
+		return nil, nil
+	}
+
+	tlsContext := &envoytlsv3.DownstreamTlsContext{}
+	if err := fc.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+		return nil, err
+	}
+	// QUIC always negotiates TLS 1.3 with its own cipher suites and the h3 application protocol
+	tlsContext.GetCommonTlsContext().TlsParams = nil
+	tlsContext.GetCommonTlsContext().AlpnProtocols = []string{"h3"}
+	quicTransport, err := utils.MessageToAny(&quicv3.QuicDownstreamTransport{
+		DownstreamTlsContext: tlsContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hcm := &envoy_hcm.HttpConnectionManager{}
+	if err := fc.GetFilters()[hcmIdx].GetTypedConfig().UnmarshalTo(hcm); err != nil {
+		return nil, err
+	}
+	hcm.CodecType = envoy_hcm.HttpConnectionManager_HTTP3
+	hcm.Http3ProtocolOptions = &envoycorev3.Http3ProtocolOptions{}
+	hcmAny, err := utils.MessageToAny(hcm)
+	if err != nil {
+		return nil, err
+	}
+
+	quicFc := proto.Clone(fc).(*envoylistenerv3.FilterChain)
+	quicFc.TransportSocket = &envoycorev3.TransportSocket{
+		Name: quicTransportSocketName,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: quicTransport,
+		},
+	}
+	quicFc.GetFilters()[hcmIdx].ConfigType = &envoylistenerv3.Filter_TypedConfig{
+		TypedConfig: hcmAny,
+	}
+	return quicFc, nil
+}
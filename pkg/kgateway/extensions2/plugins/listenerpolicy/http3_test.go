@@ -0,0 +1,107 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	quicv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func TestConvertHTTP3(t *testing.T) {
+	assert.Nil(t, convertHTTP3(nil))
+	assert.Equal(t, &http3Policy{altSvcMaxAge: defaultAltSvcMaxAge}, convertHTTP3(&kgateway.HTTP3Settings{}))
+	assert.Equal(t, &http3Policy{altSvcMaxAge: time.Hour}, convertHTTP3(&kgateway.HTTP3Settings{
+		AltSvcMaxAge: &metav1.Duration{Duration: time.Hour},
+	}))
+}
+
+func TestUDPPorts(t *testing.T) {
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{http3: &http3Policy{altSvcMaxAge: defaultAltSvcMaxAge}},
+		perPortPolicy: map[uint32]listenerPolicy{
+			8080: {},
+		},
+	}
+	assert.Equal(t, []int32{443, 8443}, pol.UDPPorts([]int32{443, 8080, 8443}))
+}
+
+func TestQuicListener(t *testing.T) {
+	hcm := &envoy_hcm.HttpConnectionManager{StatPrefix: "http"}
+	tcpListener := &envoylistenerv3.Listener{
+		Name: "listener~443",
+		Address: &envoycorev3.Address{Address: &envoycorev3.Address_SocketAddress{SocketAddress: &envoycorev3.SocketAddress{
+			Address:       "::",
+			PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: 443},
+		}}},
+		FilterChains: []*envoylistenerv3.FilterChain{
+			{
+				Name: "https",
+				Filters: []*envoylistenerv3.Filter{{
+					Name:       wellknown.HTTPConnectionManager,
+					ConfigType: &envoylistenerv3.Filter_TypedConfig{TypedConfig: utils.MustMessageToAny(hcm)},
+				}},
+				TransportSocket: &envoycorev3.TransportSocket{
+					Name: wellknown.TransportSocketTls,
+					ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(&envoytlsv3.DownstreamTlsContext{
+						CommonTlsContext: &envoytlsv3.CommonTlsContext{
+							AlpnProtocols: []string{"h2", "http/1.1"},
+							TlsParams:     &envoytlsv3.TlsParameters{TlsMaximumProtocolVersion: envoytlsv3.TlsParameters_TLSv1_2},
+						},
+					})},
+				},
+			},
+			{
+				Name: "passthrough",
+				Filters: []*envoylistenerv3.Filter{{
+					Name: wellknown.TCPProxy,
+				}},
+			},
+		},
+		ListenerFilters: []*envoylistenerv3.ListenerFilter{{Name: wellknown.TlsInspector}},
+	}
+
+	t.Run("derives a UDP listener from the filter chains terminating TLS", func(t *testing.T) {
+		out, err := quicListener(tcpListener)
+		require.NoError(t, err)
+		require.NotNil(t, out)
+
+		assert.Equal(t, "listener~443~quic", out.GetName())
+		assert.Equal(t, envoycorev3.SocketAddress_UDP, out.GetAddress().GetSocketAddress().GetProtocol())
+		assert.Equal(t, envoycorev3.SocketAddress_TCP, tcpListener.GetAddress().GetSocketAddress().GetProtocol())
+		assert.NotNil(t, out.GetUdpListenerConfig().GetQuicOptions())
+		assert.Empty(t, out.GetListenerFilters())
+		require.Len(t, out.GetFilterChains(), 1)
+
+		fc := out.GetFilterChains()[0]
+		assert.Equal(t, quicTransportSocketName, fc.GetTransportSocket().GetName())
+		quicTransport := &quicv3.QuicDownstreamTransport{}
+		require.NoError(t, fc.GetTransportSocket().GetTypedConfig().UnmarshalTo(quicTransport))
+		assert.Equal(t, []string{"h3"}, quicTransport.GetDownstreamTlsContext().GetCommonTlsContext().GetAlpnProtocols())
+		assert.Nil(t, quicTransport.GetDownstreamTlsContext().GetCommonTlsContext().GetTlsParams())
+
+		outHcm := &envoy_hcm.HttpConnectionManager{}
+		require.NoError(t, fc.GetFilters()[0].GetTypedConfig().UnmarshalTo(outHcm))
+		assert.Equal(t, envoy_hcm.HttpConnectionManager_HTTP3, outHcm.GetCodecType())
+		assert.NotNil(t, outHcm.GetHttp3ProtocolOptions())
+	})
+
+	t.Run("no listener without filter chains terminating TLS", func(t *testing.T) {
+		out, err := quicListener(&envoylistenerv3.Listener{
+			Name:         "listener~8080",
+			FilterChains: tcpListener.GetFilterChains()[1:],
+		})
+		require.NoError(t, err)
+		assert.Nil(t, out)
+	})
+}
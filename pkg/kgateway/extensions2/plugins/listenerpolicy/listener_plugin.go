@@ -2,12 +2,15 @@ package listenerpolicy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	geoipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/geoip/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
 	healthcheckv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
 	proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -19,7 +22,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -56,11 +59,14 @@ type listenerPolicy struct {
 	proxyProtocol                 *anypb.Any
 	perConnectionBufferLimitBytes *uint32
 	http                          *HttpListenerPolicyIr
+	http3                         *http3Policy
+	tls                           *tlsPolicy
+	connections                   *connectionLimits
 }
 
 func newListenerPolicy(
 	krtctx krt.HandlerContext, commoncol *collections.CommonCollections,
-	objSrc ir.ObjectSource, i *kgateway.ListenerConfig) (listenerPolicy, []error) {
+	objSrc ir.ObjectSource, port int32, i *kgateway.ListenerConfig) (listenerPolicy, []error) {
 	if i == nil {
 		return listenerPolicy{}, nil
 	}
@@ -69,11 +75,20 @@ func newListenerPolicy(
 		perConnectionBufferLimitBytes = ptr.To(uint32(*i.PerConnectionBufferLimitBytes)) //nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 2147483647, safe for uint32
 	}
 	http, errs := NewHttpListenerPolicy(krtctx, commoncol, i.HTTPSettings, objSrc)
+	tls, tlsErrs := convertTLS(krtctx, commoncol, objSrc, i.TLS)
+	errs = append(errs, tlsErrs...)
+	connections, err := convertConnectionLimits(i.Connections, objSrc, port, commoncol.Settings.EnableBuiltinRateLimit)
+	if err != nil {
+		errs = append(errs, err)
+	}
 
 	return listenerPolicy{
 		proxyProtocol:                 convertProxyProtocolConfig(objSrc, i.ProxyProtocol),
 		perConnectionBufferLimitBytes: perConnectionBufferLimitBytes,
 		http:                          http,
+		http3:                         convertHTTP3(i.HTTP3),
+		tls:                           tls,
+		connections:                   connections,
 	}, errs
 }
 
@@ -110,6 +125,18 @@ func (d listenerPolicy) Equals(d2 listenerPolicy) bool {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.http3, d2.http3) {
+		return false
+	}
+
+	if !d.tls.Equals(d2.tls) {
+		return false
+	}
+
+	if !d.connections.Equals(d2.connections) {
+		return false
+	}
+
 	return true
 }
 
@@ -137,7 +164,7 @@ func patchPolicyStatusFn(
 			ObjectMeta: sdk.CloneObjectMetaForStatus(cur.ObjectMeta),
 			Status:     policyStatus,
 		}); err != nil {
-			if errors.IsConflict(err) {
+			if apierrors.IsConflict(err) {
 				logger.Debug("error updating stale status", "ref", nn, "error", err)
 				return nil // let the conflicting Status update trigger a KRT event to requeue the updated object
 			}
@@ -147,13 +174,22 @@ func patchPolicyStatusFn(
 	}
 }
 
-var _ ir.PolicyIR = &ListenerPolicyIR{}
+var (
+	_ ir.PolicyIR         = &ListenerPolicyIR{}
+	_ ir.UDPPortsPolicyIR = &ListenerPolicyIR{}
+)
 
 type listenerPolicyPluginGwPass struct {
 	ir.UnimplementedProxyTranslationPass
 	reporter reporter.Reporter
 
 	healthCheckPolicy map[uint32]*healthcheckv3.HealthCheck
+	geoIP             map[uint32]*geoipv3.Geoip
+	geoIPHeaders      map[uint32]*header_mutationv3.HeaderMutation
+	http3             map[uint32]*http3Policy
+	tls               map[uint32]*tlsPolicy
+	clientCertHeaders map[uint32]*header_mutationv3.HeaderMutation
+	connections       map[uint32]*connectionLimits
 }
 
 var _ ir.ProxyTranslationPass = &listenerPolicyPluginGwPass{}
@@ -171,11 +207,11 @@ func NewListenerPolicyIR(
 	errs := []error{}
 	perPort := map[uint32]listenerPolicy{}
 	for _, portConfig := range spec.PerPort {
-		pol, errs2 := newListenerPolicy(krtctx, commoncol, objSrc, &portConfig.Listener)
+		pol, errs2 := newListenerPolicy(krtctx, commoncol, objSrc, portConfig.Port, &portConfig.Listener)
 		perPort[uint32(portConfig.Port)] = pol //nolint:gosec // G115: we have CEL validation that this is at least 1.
 		errs = append(errs, errs2...)
 	}
-	defaultPolicy, errs2 := newListenerPolicy(krtctx, commoncol, objSrc, spec.Default)
+	defaultPolicy, errs2 := newListenerPolicy(krtctx, commoncol, objSrc, 0, spec.Default)
 	errs = append(errs, errs2...)
 	return &ListenerPolicyIR{
 		ct:            ct,
@@ -262,6 +298,12 @@ func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Repor
 	return &listenerPolicyPluginGwPass{
 		reporter:          reporter,
 		healthCheckPolicy: map[uint32]*healthcheckv3.HealthCheck{},
+		geoIP:             map[uint32]*geoipv3.Geoip{},
+		geoIPHeaders:      map[uint32]*header_mutationv3.HeaderMutation{},
+		http3:             map[uint32]*http3Policy{},
+		tls:               map[uint32]*tlsPolicy{},
+		clientCertHeaders: map[uint32]*header_mutationv3.HeaderMutation{},
+		connections:       map[uint32]*connectionLimits{},
 	}
 }
 
@@ -299,27 +341,129 @@ func (p *listenerPolicyPluginGwPass) ApplyListenerPlugin(
 	}
 	if http := cfg.http; http != nil {
 		p.healthCheckPolicy[pCtx.Port] = http.healthCheckPolicy
+		p.geoIP[pCtx.Port] = http.geoIP
+		p.geoIPHeaders[pCtx.Port] = http.geoIPHeaders
 	}
+	p.http3[pCtx.Port] = cfg.http3
+	p.tls[pCtx.Port] = cfg.tls
+	if cfg.tls != nil {
+		p.clientCertHeaders[pCtx.Port] = cfg.tls.clientCertHeaders
+	} else {
+		delete(p.clientCertHeaders, pCtx.Port)
+	}
+	p.connections[pCtx.Port] = cfg.connections
 }
 
 func (p *listenerPolicyPluginGwPass) HttpFilters(hCtx ir.HttpFiltersContext, fc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
-	healthCheckPolicy := p.healthCheckPolicy[hCtx.ListenerPort]
-	if healthCheckPolicy == nil {
-		return nil, nil
+	var stagedFilters []filters.StagedHttpFilter
+
+	// Advertise the HTTP/3 listener in the responses of HTTPS listeners. The filter is added first,
+	// so that the header is also added to local replies of other filters
+	if http3 := p.http3[hCtx.ListenerPort]; http3 != nil && fc.TLS != nil {
+		stagedFilter, err := filters.NewStagedFilter(
+			altSvcFilterName,
+			altSvcFilter(hCtx.ListenerPort, http3),
+			filters.BeforeStage(filters.FaultStage),
+		)
+		if err != nil {
+			return nil, err
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+
+	// Set the client certificate headers before any filter that may make decisions based on them.
+	// The filter is added to all filter chains, so that the headers can't be spoofed over plain HTTP
+	if clientCertHeaders := p.clientCertHeaders[hCtx.ListenerPort]; clientCertHeaders != nil {
+		stagedFilter, err := filters.NewStagedFilter(
+			clientCertHeadersFilterName,
+			clientCertHeaders,
+			filters.BeforeStage(filters.FaultStage),
+		)
+		if err != nil {
+			return nil, err
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+
+	// Remove the geolocation headers sent by clients before the geoip filter, which doesn't overwrite
+	// them when the lookup fails
+	if geoIPHeaders := p.geoIPHeaders[hCtx.ListenerPort]; geoIPHeaders != nil {
+		stagedFilter, err := filters.NewStagedFilter(
+			geoIPHeadersFilterName,
+			geoIPHeaders,
+			filters.BeforeStage(filters.FaultStage),
+		)
+		if err != nil {
+			return nil, err
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+
+	// Add the geoip filter right after the fault stage, so that the geolocation headers are available
+	// to all filters that make decisions based on request headers, e.g. RBAC
+	if geoIP := p.geoIP[hCtx.ListenerPort]; geoIP != nil {
+		stagedFilter, err := filters.NewStagedFilter(
+			"envoy.filters.http.geoip",
+			geoIP,
+			filters.AfterStage(filters.FaultStage),
+		)
+		if err != nil {
+			return nil, err
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
 	}
 
 	// Add the health check filter after the authz filter but before the rate limit filter
 	// This allows the health check filter to be secured by authz if needed, but ensures it won't be rate limited
-	stagedFilter, err := filters.NewStagedFilter(
-		"envoy.filters.http.health_check",
-		healthCheckPolicy,
-		filters.AfterStage(filters.AuthZStage),
-	)
-	if err != nil {
-		return nil, err
+	if healthCheckPolicy := p.healthCheckPolicy[hCtx.ListenerPort]; healthCheckPolicy != nil {
+		stagedFilter, err := filters.NewStagedFilter(
+			"envoy.filters.http.health_check",
+			healthCheckPolicy,
+			filters.AfterStage(filters.AuthZStage),
+		)
+		if err != nil {
+			return nil, err
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+
+	return stagedFilters, nil
+}
+
+// FinalizeListener applies the TLS settings and the connection limits to the translated filter chains,
+// and returns the HTTP/3 listener derived from the listener if HTTP/3 is enabled.
+func (p *listenerPolicyPluginGwPass) FinalizeListener(
+	pCtx *ir.FinalizeListenerContext,
+	out *envoylistenerv3.Listener,
+) ([]*envoylistenerv3.Listener, error) {
+	var errs []error
+	// TLS settings are applied first, so that they are shared by the derived HTTP/3 listener
+	if tls := p.tls[pCtx.Port]; tls != nil {
+		if err := tls.applyToListener(out); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	return []filters.StagedHttpFilter{stagedFilter}, nil
+	var res []*envoylistenerv3.Listener
+	if p.http3[pCtx.Port] != nil {
+		listener, err := quicListener(out)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case listener == nil:
+			logger.Debug("no filter chains terminating TLS for HTTP/3", "listener", out.GetName())
+		default:
+			res = append(res, listener)
+		}
+	}
+
+	// Connection limits are applied last, as they only apply to TCP connections
+	if connections := p.connections[pCtx.Port]; connections != nil {
+		if err := connections.applyToListener(out); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return res, errors.Join(errs...)
 }
 
 func (p *listenerPolicyPluginGwPass) ApplyHCM(
@@ -329,6 +473,10 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 	logger.Debug("applying to HCM", "listener_port", pCtx.ListenerPort, "policy_type", fmt.Sprintf("%T", pCtx.Policy))
 
 	cfg := p.getPolicy(pCtx.Policy, pCtx.ListenerPort)
+	if cfg.tls != nil {
+		cfg.tls.xfcc.applyToHCM(out)
+	}
+
 	policy := cfg.http
 	if policy == nil {
 		return nil
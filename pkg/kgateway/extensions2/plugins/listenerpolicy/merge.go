@@ -90,6 +90,9 @@ func mergeListenerPolicy(
 		mergeProxyProtocol,
 		mergePerConnectionBufferLimitBytes,
 		mergeHttpSettings,
+		mergeHTTP3,
+		mergeTLS,
+		mergeConnections,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -147,3 +150,51 @@ func mergeHttpSettings(
 	}
 	MergeHttpPolicies(origin, p1.http, p2.http, p2Ref, p2MergeOrigins, opts, mergeOrigins, "" /*no merge settings*/)
 }
+
+func mergeHTTP3(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.http3, p2.http3, opts) {
+		return
+	}
+
+	p1.http3 = p2.http3
+	mergeOrigins.SetOne(origin+"http3", p2Ref, p2MergeOrigins)
+}
+
+func mergeTLS(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.tls, p2.tls, opts) {
+		return
+	}
+
+	p1.tls = p2.tls
+	mergeOrigins.SetOne(origin+"tls", p2Ref, p2MergeOrigins)
+}
+
+func mergeConnections(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.connections, p2.connections, opts) {
+		return
+	}
+
+	p1.connections = p2.connections
+	mergeOrigins.SetOne(origin+"connections", p2Ref, p2MergeOrigins)
+}
@@ -34,6 +34,7 @@ func MergeHttpPolicies(
 		mergeAcceptHttp10,
 		mergeDefaultHostForHttp10,
 		mergeEarlyHeaderMutation,
+		mergeGeoIP,
 	}
 	for _, mergeFunc := range mergeFuncs {
 		mergeFunc(origin, p1, p2, p2Ref, p2MergeOrigins, mergeOpts, mergeOrigins)
@@ -256,3 +257,20 @@ func mergeEarlyHeaderMutation(
 	p1.earlyHeaderMutationExtensions = slices.Clone(p2.earlyHeaderMutationExtensions)
 	mergeOrigins.SetOne(origin+"earlyHeaderMutationExtensions", p2Ref, p2MergeOrigins)
 }
+
+func mergeGeoIP(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.geoIP, p2.geoIP, opts) {
+		return
+	}
+
+	p1.geoIP = p2.geoIP
+	p1.geoIPHeaders = p2.geoIPHeaders
+	mergeOrigins.SetOne(origin+"geoIP", p2Ref, p2MergeOrigins)
+}
@@ -0,0 +1,448 @@
+package listenerpolicy
+
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	kgwwellknown "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const (
+	clientCertHeadersFilterName = "envoy.filters.http.header_mutation/client_cert"
+
+	crlKey = "ca.crl"
+)
+
+// denyAllCertificateHash is pinned when the client validation cannot be translated, so that
+// no client certificate is accepted instead of silently accepting unauthenticated clients.
+var denyAllCertificateHash = strings.Repeat("0", 64)
+
+var clientCertFieldFormatters = map[kgateway.ClientCertField]string{
+	kgateway.ClientCertFieldSubject:           "%DOWNSTREAM_PEER_SUBJECT%",
+	kgateway.ClientCertFieldIssuer:            "%DOWNSTREAM_PEER_ISSUER%",
+	kgateway.ClientCertFieldURISAN:            "%DOWNSTREAM_PEER_URI_SAN%",
+	kgateway.ClientCertFieldDNSSAN:            "%DOWNSTREAM_PEER_DNS_SAN%",
+	kgateway.ClientCertFieldSerial:            "%DOWNSTREAM_PEER_SERIAL%",
+	kgateway.ClientCertFieldFingerprintSHA256: "%DOWNSTREAM_PEER_FINGERPRINT_256%",
+	kgateway.ClientCertFieldCertificate:       "%DOWNSTREAM_PEER_CERT%",
+}
+
+type tlsPolicy struct {
+	// validationContext replaces the validation context of the filter chains terminating TLS when set
+	validationContext        *envoytlsv3.CertificateValidationContext
+	requireClientCertificate bool
+
+	// unset TLS parameters keep the settings of the Gateway listener
+	minVersion    *envoytlsv3.TlsParameters_TlsProtocol
+	maxVersion    *envoytlsv3.TlsParameters_TlsProtocol
+	cipherSuites  []string
+	ecdhCurves    []string
+	alpnProtocols []string
+
+	xfcc              *xfccPolicy
+	clientCertHeaders *header_mutationv3.HeaderMutation
+}
+
+type xfccPolicy struct {
+	forwardClientCertDetails    envoy_hcm.HttpConnectionManager_ForwardClientCertDetails
+	setCurrentClientCertDetails *envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails
+}
+
+func (t *tlsPolicy) Equals(other *tlsPolicy) bool {
+	return cmputils.CompareWithNils(t, other, func(a, b *tlsPolicy) bool {
+		return proto.Equal(a.validationContext, b.validationContext) &&
+			a.requireClientCertificate == b.requireClientCertificate &&
+			cmputils.PointerValsEqual(a.minVersion, b.minVersion) &&
+			cmputils.PointerValsEqual(a.maxVersion, b.maxVersion) &&
+			slices.Equal(a.cipherSuites, b.cipherSuites) &&
+			slices.Equal(a.ecdhCurves, b.ecdhCurves) &&
+			slices.Equal(a.alpnProtocols, b.alpnProtocols) &&
+			a.xfcc.Equals(b.xfcc) &&
+			proto.Equal(a.clientCertHeaders, b.clientCertHeaders)
+	})
+}
+
+func (x *xfccPolicy) Equals(other *xfccPolicy) bool {
+	return cmputils.CompareWithNils(x, other, func(a, b *xfccPolicy) bool {
+		return a.forwardClientCertDetails == b.forwardClientCertDetails &&
+			proto.Equal(a.setCurrentClientCertDetails, b.setCurrentClientCertDetails)
+	})
+}
+
+func convertTLS(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	objSrc ir.ObjectSource,
+	spec *kgateway.ListenerTLSConfig,
+) (*tlsPolicy, []error) {
+	if spec == nil {
+		return nil, nil
+	}
+	var errs []error
+	pol := &tlsPolicy{
+		alpnProtocols: spec.AlpnProtocols,
+	}
+
+	if spec.ClientValidation != nil {
+		validationContext, err := convertClientValidation(krtctx, commoncol, objSrc, spec.ClientValidation)
+		if err != nil {
+			errs = append(errs, err)
+			validationContext = &envoytlsv3.CertificateValidationContext{
+				VerifyCertificateHash: []string{denyAllCertificateHash},
+			}
+		}
+		pol.validationContext = validationContext
+		pol.requireClientCertificate = spec.ClientValidation.Mode == nil ||
+			*spec.ClientValidation.Mode == kgateway.ClientCertificateValidationModeRequired
+	}
+
+	if params := spec.Parameters; params != nil {
+		if params.MinVersion != nil {
+			pol.minVersion = toTlsProtocol(*params.MinVersion)
+		}
+		if params.MaxVersion != nil {
+			pol.maxVersion = toTlsProtocol(*params.MaxVersion)
+		}
+		pol.cipherSuites = params.CipherSuites
+		pol.ecdhCurves = params.EcdhCurves
+	}
+
+	if fcc := spec.ForwardClientCert; fcc != nil {
+		if fcc.Mode != nil {
+			pol.xfcc = convertXfcc(*fcc.Mode, fcc.Details)
+		}
+		pol.clientCertHeaders = clientCertHeadersFilter(fcc.Headers)
+	}
+	return pol, errs
+}
+
+func convertClientValidation(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	objSrc ir.ObjectSource,
+	spec *kgateway.ClientCertificateValidation,
+) (*envoytlsv3.CertificateValidationContext, error) {
+	from := krtcollections.From{
+		GroupKind: kgwwellknown.ListenerPolicyGVK.GroupKind(),
+		Namespace: objSrc.Namespace,
+	}
+	out := &envoytlsv3.CertificateValidationContext{
+		VerifyCertificateHash: spec.CertificateHashes,
+		VerifyCertificateSpki: spec.SPKIHashes,
+	}
+
+	var trustedCa []string
+	for _, ref := range spec.CACertificateRefs {
+		ca, err := fetchCACertificate(krtctx, commoncol, from, ref)
+		if err != nil {
+			return nil, err
+		}
+		trustedCa = append(trustedCa, ca)
+	}
+	if len(trustedCa) > 0 {
+		out.TrustedCa = inlineStringDataSource(strings.Join(trustedCa, "\n"))
+	}
+
+	var crls []string
+	for _, ref := range spec.CRLRefs {
+		crl, err := fetchCRL(krtctx, commoncol, from, ref)
+		if err != nil {
+			return nil, err
+		}
+		crls = append(crls, crl)
+	}
+	if len(crls) > 0 {
+		out.Crl = inlineStringDataSource(strings.Join(crls, "\n"))
+	}
+
+	for _, san := range spec.SubjectAltNames {
+		out.MatchTypedSubjectAltNames = append(out.MatchTypedSubjectAltNames, &envoytlsv3.SubjectAltNameMatcher{
+			SanType: toSanType(san.Type),
+			Matcher: &envoymatcher.StringMatcher{
+				MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: san.Value},
+			},
+		})
+	}
+	return out, nil
+}
+
+// fetchCACertificate returns the validated CA certificates in the `ca.crt` key of a ConfigMap or Secret.
+func fetchCACertificate(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	from krtcollections.From,
+	ref gwv1.ObjectReference,
+) (string, error) {
+	switch ref.Kind {
+	case gwv1.Kind(kgwwellknown.ConfigMapGVK.Kind):
+		cm, err := commoncol.ConfigMaps.GetConfigMap(krtctx, from, ref)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch CA certificate ConfigMap %s: %w", ref.Name, err)
+		}
+		ca, err := sslutils.GetCACertFromConfigMap(cm)
+		if err != nil {
+			return "", fmt.Errorf("failed to extract CA certificate from ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+		}
+		return ca, nil
+	case gwv1.Kind(kgwwellknown.SecretGVK.Kind):
+		secret, err := commoncol.Secrets.GetSecret(krtctx, from, toSecretObjectReference(ref))
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch CA certificate Secret %s: %w", ref.Name, err)
+		}
+		ca, err := sslutils.GetCACertFromSecret(secret)
+		if err != nil {
+			return "", fmt.Errorf("failed to extract CA certificate from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		return ca, nil
+	default:
+		return "", fmt.Errorf("unsupported CA certificate reference kind: %s", ref.Kind)
+	}
+}
+
+// fetchCRL returns the certificate revocation lists in the `ca.crl` key of a ConfigMap or Secret.
+func fetchCRL(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	from krtcollections.From,
+	ref gwv1.ObjectReference,
+) (string, error) {
+	var crl string
+	switch ref.Kind {
+	case gwv1.Kind(kgwwellknown.ConfigMapGVK.Kind):
+		cm, err := commoncol.ConfigMaps.GetConfigMap(krtctx, from, ref)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch CRL ConfigMap %s: %w", ref.Name, err)
+		}
+		crl = cm.Data[crlKey]
+	case gwv1.Kind(kgwwellknown.SecretGVK.Kind):
+		secret, err := commoncol.Secrets.GetSecret(krtctx, from, toSecretObjectReference(ref))
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch CRL Secret %s: %w", ref.Name, err)
+		}
+		crl = string(secret.Data[crlKey])
+	default:
+		return "", fmt.Errorf("unsupported CRL reference kind: %s", ref.Kind)
+	}
+	if err := validateCRL(crl); err != nil {
+		return "", fmt.Errorf("invalid %s in %s %s: %w", crlKey, ref.Kind, ref.Name, err)
+	}
+	return crl, nil
+}
+
+func validateCRL(in string) error {
+	if in == "" {
+		return errors.New("key missing")
+	}
+	rest := []byte(in)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "X509 CRL" {
+			return fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		found = true
+	}
+	if !found {
+		return errors.New("no PEM encoded CRL found")
+	}
+	return nil
+}
+
+func toSecretObjectReference(ref gwv1.ObjectReference) gwv1.SecretObjectReference {
+	return gwv1.SecretObjectReference{
+		Group:     &ref.Group,
+		Kind:      &ref.Kind,
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+}
+
+func inlineStringDataSource(s string) *envoycorev3.DataSource {
+	return &envoycorev3.DataSource{
+		Specifier: &envoycorev3.DataSource_InlineString{
+			InlineString: s,
+		},
+	}
+}
+
+func toSanType(in kgateway.SubjectAltNameType) envoytlsv3.SubjectAltNameMatcher_SanType {
+	switch in {
+	case kgateway.SubjectAltNameTypeURI:
+		return envoytlsv3.SubjectAltNameMatcher_URI
+	case kgateway.SubjectAltNameTypeEmail:
+		return envoytlsv3.SubjectAltNameMatcher_EMAIL
+	case kgateway.SubjectAltNameTypeIPAddress:
+		return envoytlsv3.SubjectAltNameMatcher_IP_ADDRESS
+	default:
+		return envoytlsv3.SubjectAltNameMatcher_DNS
+	}
+}
+
+func toTlsProtocol(in kgateway.TLSVersion) *envoytlsv3.TlsParameters_TlsProtocol {
+	var out envoytlsv3.TlsParameters_TlsProtocol
+	switch in {
+	case kgateway.TLSVersion1_0:
+		out = envoytlsv3.TlsParameters_TLSv1_0
+	case kgateway.TLSVersion1_1:
+		out = envoytlsv3.TlsParameters_TLSv1_1
+	case kgateway.TLSVersion1_2:
+		out = envoytlsv3.TlsParameters_TLSv1_2
+	case kgateway.TLSVersion1_3:
+		out = envoytlsv3.TlsParameters_TLSv1_3
+	default:
+		out = envoytlsv3.TlsParameters_TLS_AUTO
+	}
+	return &out
+}
+
+func convertXfcc(mode kgateway.ForwardClientCertMode, details []kgateway.ForwardClientCertDetail) *xfccPolicy {
+	out := &xfccPolicy{}
+	switch mode {
+	case kgateway.ForwardClientCertModeForwardOnly:
+		out.forwardClientCertDetails = envoy_hcm.HttpConnectionManager_FORWARD_ONLY
+	case kgateway.ForwardClientCertModeAppendForward:
+		out.forwardClientCertDetails = envoy_hcm.HttpConnectionManager_APPEND_FORWARD
+	case kgateway.ForwardClientCertModeSanitizeSet:
+		out.forwardClientCertDetails = envoy_hcm.HttpConnectionManager_SANITIZE_SET
+	case kgateway.ForwardClientCertModeAlwaysForwardOnly:
+		out.forwardClientCertDetails = envoy_hcm.HttpConnectionManager_ALWAYS_FORWARD_ONLY
+	default:
+		out.forwardClientCertDetails = envoy_hcm.HttpConnectionManager_SANITIZE
+	}
+	if len(details) == 0 {
+		return out
+	}
+	out.setCurrentClientCertDetails = &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+		Subject: wrapperspb.Bool(slices.Contains(details, kgateway.ForwardClientCertDetailSubject)),
+		Cert:    slices.Contains(details, kgateway.ForwardClientCertDetailCert),
+		Chain:   slices.Contains(details, kgateway.ForwardClientCertDetailChain),
+		Dns:     slices.Contains(details, kgateway.ForwardClientCertDetailDNS),
+		Uri:     slices.Contains(details, kgateway.ForwardClientCertDetailURI),
+	}
+	return out
+}
+
+// clientCertHeadersFilter returns a filter setting request headers to fields of the client certificate.
+// Headers sent by clients are removed first so that they cannot be spoofed. Envoy doesn't add headers
+// whose value is empty, which is the case when the client did not present a certificate.
+func clientCertHeadersFilter(headers []kgateway.ClientCertHeader) *header_mutationv3.HeaderMutation {
+	if len(headers) == 0 {
+		return nil
+	}
+	mutations := &header_mutationv3.Mutations{}
+	for _, header := range headers {
+		name := strings.ToLower(string(header.Name))
+		mutations.RequestMutations = append(mutations.RequestMutations,
+			&mutation_rulesv3.HeaderMutation{
+				Action: &mutation_rulesv3.HeaderMutation_Remove{Remove: name},
+			},
+			&mutation_rulesv3.HeaderMutation{
+				Action: &mutation_rulesv3.HeaderMutation_Append{
+					Append: &envoycorev3.HeaderValueOption{
+						Header: &envoycorev3.HeaderValue{
+							Key:   name,
+							Value: clientCertFieldFormatters[header.Field],
+						},
+						AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+					},
+				},
+			},
+		)
+	}
+	return &header_mutationv3.HeaderMutation{Mutations: mutations}
+}
+
+// applyToListener applies the TLS settings to the filter chains of the listener that terminate TLS.
+func (t *tlsPolicy) applyToListener(out *envoylistenerv3.Listener) error {
+	for _, fc := range out.GetFilterChains() {
+		if err := t.applyToFilterChain(fc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tlsPolicy) applyToFilterChain(fc *envoylistenerv3.FilterChain) error {
+	if fc.GetTransportSocket().GetName() != wellknown.TransportSocketTls {
+		return nil
+	}
+	tlsContext := &envoytlsv3.DownstreamTlsContext{}
+	if err := fc.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+		return err
+	}
+	if tlsContext.CommonTlsContext == nil {
+		tlsContext.CommonTlsContext = &envoytlsv3.CommonTlsContext{}
+	}
+	common := tlsContext.GetCommonTlsContext()
+
+	if t.validationContext != nil {
+		common.ValidationContextType = &envoytlsv3.CommonTlsContext_ValidationContext{
+			ValidationContext: proto.Clone(t.validationContext).(*envoytlsv3.CertificateValidationContext),
+		}
+		tlsContext.RequireClientCertificate = wrapperspb.Bool(t.requireClientCertificate)
+	}
+
+	if t.minVersion != nil || t.maxVersion != nil || len(t.cipherSuites) > 0 || len(t.ecdhCurves) > 0 {
+		if common.TlsParams == nil {
+			common.TlsParams = &envoytlsv3.TlsParameters{}
+		}
+		if t.minVersion != nil {
+			common.TlsParams.TlsMinimumProtocolVersion = *t.minVersion
+		}
+		if t.maxVersion != nil {
+			common.TlsParams.TlsMaximumProtocolVersion = *t.maxVersion
+		}
+		if len(t.cipherSuites) > 0 {
+			common.TlsParams.CipherSuites = t.cipherSuites
+		}
+		if len(t.ecdhCurves) > 0 {
+			common.TlsParams.EcdhCurves = t.ecdhCurves
+		}
+	}
+	if len(t.alpnProtocols) > 0 {
+		common.AlpnProtocols = t.alpnProtocols
+	}
+
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return err
+	}
+	fc.GetTransportSocket().ConfigType = &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig}
+	return nil
+}
+
+// applyToHCM configures the forwarding of the client certificate details in the XFCC header.
+func (x *xfccPolicy) applyToHCM(out *envoy_hcm.HttpConnectionManager) {
+	if x == nil {
+		return
+	}
+	out.ForwardClientCertDetails = x.forwardClientCertDetails
+	out.SetCurrentClientCertDetails = x.setCurrentClientCertDetails
+}
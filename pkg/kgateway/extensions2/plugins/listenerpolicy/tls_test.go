@@ -0,0 +1,112 @@
+package listenerpolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyTLSToListener(t *testing.T) {
+	gatewayTls := &envoytlsv3.DownstreamTlsContext{
+		CommonTlsContext: &envoytlsv3.CommonTlsContext{
+			AlpnProtocols: []string{"h2", "http/1.1"},
+			TlsParams: &envoytlsv3.TlsParameters{
+				TlsMaximumProtocolVersion: envoytlsv3.TlsParameters_TLSv1_3,
+				EcdhCurves:                []string{"X25519"},
+			},
+		},
+	}
+	listener := &envoylistenerv3.Listener{
+		FilterChains: []*envoylistenerv3.FilterChain{
+			{
+				Name: "https",
+				TransportSocket: &envoycorev3.TransportSocket{
+					Name: wellknown.TransportSocketTls,
+					ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(gatewayTls),
+					},
+				},
+			},
+			{
+				Name: "http",
+			},
+		},
+	}
+
+	pol, errs := convertTLS(nil, nil, ir.ObjectSource{}, &kgateway.ListenerTLSConfig{
+		ClientValidation: &kgateway.ClientCertificateValidation{
+			SPKIHashes: []string{"NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="},
+			Mode:       ptr.To(kgateway.ClientCertificateValidationModeOptional),
+		},
+		Parameters: &kgateway.TLSParameters{
+			MinVersion:   ptr.To(kgateway.TLSVersion1_2),
+			CipherSuites: []string{"ECDHE-RSA-AES128-GCM-SHA256"},
+		},
+		AlpnProtocols: []string{"h2"},
+	})
+	require.Empty(t, errs)
+	require.NoError(t, pol.applyToListener(listener))
+
+	assert.Nil(t, listener.GetFilterChains()[1].GetTransportSocket())
+	out := &envoytlsv3.DownstreamTlsContext{}
+	require.NoError(t, listener.GetFilterChains()[0].GetTransportSocket().GetTypedConfig().UnmarshalTo(out))
+	require.NotNil(t, out.GetRequireClientCertificate())
+	assert.False(t, out.GetRequireClientCertificate().GetValue())
+	assert.Equal(t, []string{"NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="}, out.GetCommonTlsContext().GetValidationContext().GetVerifyCertificateSpki())
+	assert.Equal(t, []string{"h2"}, out.GetCommonTlsContext().GetAlpnProtocols())
+
+	// unset parameters keep the settings of the Gateway listener
+	params := out.GetCommonTlsContext().GetTlsParams()
+	assert.Equal(t, envoytlsv3.TlsParameters_TLSv1_2, params.GetTlsMinimumProtocolVersion())
+	assert.Equal(t, envoytlsv3.TlsParameters_TLSv1_3, params.GetTlsMaximumProtocolVersion())
+	assert.Equal(t, []string{"ECDHE-RSA-AES128-GCM-SHA256"}, params.GetCipherSuites())
+	assert.Equal(t, []string{"X25519"}, params.GetEcdhCurves())
+}
+
+func TestConvertXfcc(t *testing.T) {
+	out := convertXfcc(kgateway.ForwardClientCertModeAppendForward, []kgateway.ForwardClientCertDetail{
+		kgateway.ForwardClientCertDetailCert,
+		kgateway.ForwardClientCertDetailDNS,
+	})
+	assert.Equal(t, envoy_hcm.HttpConnectionManager_APPEND_FORWARD, out.forwardClientCertDetails)
+	assert.False(t, out.setCurrentClientCertDetails.GetSubject().GetValue())
+	assert.True(t, out.setCurrentClientCertDetails.GetCert())
+	assert.True(t, out.setCurrentClientCertDetails.GetDns())
+	assert.False(t, out.setCurrentClientCertDetails.GetUri())
+
+	out = convertXfcc(kgateway.ForwardClientCertModeSanitize, nil)
+	assert.Equal(t, envoy_hcm.HttpConnectionManager_SANITIZE, out.forwardClientCertDetails)
+	assert.Nil(t, out.setCurrentClientCertDetails)
+}
+
+func TestClientCertHeadersFilter(t *testing.T) {
+	assert.Nil(t, clientCertHeadersFilter(nil))
+
+	out := clientCertHeadersFilter([]kgateway.ClientCertHeader{{
+		Name:  "X-Client-URI",
+		Field: kgateway.ClientCertFieldURISAN,
+	}})
+	mutations := out.GetMutations().GetRequestMutations()
+	require.Len(t, mutations, 2)
+	assert.Equal(t, "x-client-uri", mutations[0].GetRemove())
+	assert.Equal(t, "x-client-uri", mutations[1].GetAppend().GetHeader().GetKey())
+	assert.Equal(t, "%DOWNSTREAM_PEER_URI_SAN%", mutations[1].GetAppend().GetHeader().GetValue())
+}
+
+func TestValidateCRL(t *testing.T) {
+	assert.NoError(t, validateCRL("-----BEGIN X509 CRL-----\nMAA=\n-----END X509 CRL-----\n"))
+	assert.Error(t, validateCRL(""))
+	assert.Error(t, validateCRL("not a crl"))
+	assert.Error(t, validateCRL("-----BEGIN CERTIFICATE-----\nMAA=\n-----END CERTIFICATE-----\n"))
+}
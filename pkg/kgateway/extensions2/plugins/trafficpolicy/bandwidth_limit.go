@@ -0,0 +1,169 @@
+package trafficpolicy
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoybandwidthlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	// The bandwidth limit filter only supports a single limit, so request and response
+	// bodies are limited by separate filters.
+	bandwidthLimitRequestFilterName  = "envoy.filters.http.bandwidth_limit/request"
+	bandwidthLimitResponseFilterName = "envoy.filters.http.bandwidth_limit/response"
+
+	bandwidthLimitRequestStatPrefix  = "bandwidth_limit_request"
+	bandwidthLimitResponseStatPrefix = "bandwidth_limit_response"
+)
+
+type bandwidthLimitIR struct {
+	// request and response are the per-route configurations of the request and response filters.
+	// A nil configuration disables the filter for the policy targets.
+	request  *envoybandwidthlimitv3.BandwidthLimit
+	response *envoybandwidthlimitv3.BandwidthLimit
+}
+
+var _ PolicySubIR = &bandwidthLimitIR{}
+
+func (b *bandwidthLimitIR) Equals(other PolicySubIR) bool {
+	otherBandwidthLimit, ok := other.(*bandwidthLimitIR)
+	if !ok {
+		return false
+	}
+	if b == nil || otherBandwidthLimit == nil {
+		return b == nil && otherBandwidthLimit == nil
+	}
+	return proto.Equal(b.request, otherBandwidthLimit.request) &&
+		proto.Equal(b.response, otherBandwidthLimit.response)
+}
+
+func (b *bandwidthLimitIR) Validate() error {
+	if b == nil {
+		return nil
+	}
+	if b.request != nil {
+		if err := b.request.Validate(); err != nil {
+			return err
+		}
+	}
+	if b.response != nil {
+		if err := b.response.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructBandwidthLimit constructs the bandwidth limit policy IR from the policy specification.
+func constructBandwidthLimit(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.BandwidthLimit == nil {
+		return
+	}
+
+	// Disabling leaves both configurations unset
+	if spec.BandwidthLimit.Disable != nil {
+		out.bandwidthLimit = &bandwidthLimitIR{}
+		return
+	}
+
+	out.bandwidthLimit = &bandwidthLimitIR{
+		request: toBandwidthLimit(
+			spec.BandwidthLimit,
+			spec.BandwidthLimit.RequestLimitKiBps,
+			bandwidthLimitRequestStatPrefix,
+			envoybandwidthlimitv3.BandwidthLimit_REQUEST,
+		),
+		response: toBandwidthLimit(
+			spec.BandwidthLimit,
+			spec.BandwidthLimit.ResponseLimitKiBps,
+			bandwidthLimitResponseStatPrefix,
+			envoybandwidthlimitv3.BandwidthLimit_RESPONSE,
+		),
+	}
+}
+
+func toBandwidthLimit(
+	spec *kgateway.BandwidthLimit,
+	limitKiBps *int32,
+	statPrefix string,
+	mode envoybandwidthlimitv3.BandwidthLimit_EnableMode,
+) *envoybandwidthlimitv3.BandwidthLimit {
+	if limitKiBps == nil {
+		return nil
+	}
+
+	limit := &envoybandwidthlimitv3.BandwidthLimit{
+		StatPrefix: statPrefix,
+		EnableMode: mode,
+		LimitKbps:  wrapperspb.UInt64(uint64(*limitKiBps)), // nolint:gosec // G115: kubebuilder validation ensures the value is positive
+	}
+	if spec.FillInterval != nil {
+		limit.FillInterval = durationpb.New(spec.FillInterval.Duration)
+	}
+	if spec.RuntimeKey != nil {
+		limit.RuntimeEnabled = &envoycorev3.RuntimeFeatureFlag{
+			DefaultValue: wrapperspb.Bool(true),
+			RuntimeKey:   *spec.RuntimeKey,
+		}
+	}
+	return limit
+}
+
+func (p *trafficPolicyPluginGwPass) handleBandwidthLimit(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, bandwidthLimit *bandwidthLimitIR) {
+	if bandwidthLimit == nil {
+		return
+	}
+
+	// Add the limits to the typed_per_filter_config for route-level override. A direction without
+	// a limit is explicitly disabled so that it does not inherit a limit from a less specific level.
+	if bandwidthLimit.request != nil {
+		pCtxTypedFilterConfig.AddTypedConfig(bandwidthLimitRequestFilterName, bandwidthLimit.request)
+	} else {
+		pCtxTypedFilterConfig.AddTypedConfig(bandwidthLimitRequestFilterName, DisableFilterPerRoute())
+	}
+	if bandwidthLimit.response != nil {
+		pCtxTypedFilterConfig.AddTypedConfig(bandwidthLimitResponseFilterName, bandwidthLimit.response)
+	} else {
+		pCtxTypedFilterConfig.AddTypedConfig(bandwidthLimitResponseFilterName, DisableFilterPerRoute())
+	}
+
+	// Add the filters to the chain. When having a bandwidth limit policy for a route we need to also have
+	// globally disabled bandwidth limit filters in the chain otherwise it will be ignored.
+	if bandwidthLimit.request == nil && bandwidthLimit.response == nil {
+		return
+	}
+	if p.bandwidthLimitInChain == nil {
+		p.bandwidthLimitInChain = make(map[string]bool)
+	}
+	p.bandwidthLimitInChain[fcn] = true
+}
+
+// bandwidthLimitFilters returns the disabled request and response bandwidth limit filters
+// that are enabled on the routes with a bandwidth limit policy.
+func bandwidthLimitFilters() []filters.StagedHttpFilter {
+	requestFilter := filters.MustNewStagedFilter(
+		bandwidthLimitRequestFilterName,
+		&envoybandwidthlimitv3.BandwidthLimit{
+			StatPrefix: bandwidthLimitRequestStatPrefix,
+		},
+		filters.DuringStage(filters.RateLimitStage),
+	)
+	requestFilter.Filter.Disabled = true
+
+	responseFilter := filters.MustNewStagedFilter(
+		bandwidthLimitResponseFilterName,
+		&envoybandwidthlimitv3.BandwidthLimit{
+			StatPrefix: bandwidthLimitResponseStatPrefix,
+		},
+		filters.DuringStage(filters.RateLimitStage),
+	)
+	responseFilter.Filter.Disabled = true
+
+	return []filters.StagedHttpFilter{requestFilter, responseFilter}
+}
@@ -0,0 +1,121 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoybandwidthlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructBandwidthLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         *kgateway.BandwidthLimit
+		wantRequest  *envoybandwidthlimitv3.BandwidthLimit
+		wantResponse *envoybandwidthlimitv3.BandwidthLimit
+	}{
+		{
+			name: "request and response limits",
+			spec: &kgateway.BandwidthLimit{
+				RequestLimitKiBps:  ptr.To(int32(512)),
+				ResponseLimitKiBps: ptr.To(int32(1024)),
+				FillInterval:       &metav1.Duration{Duration: 100 * time.Millisecond},
+				RuntimeKey:         ptr.To("bandwidth_limit.enabled"),
+			},
+			wantRequest: &envoybandwidthlimitv3.BandwidthLimit{
+				StatPrefix:   bandwidthLimitRequestStatPrefix,
+				EnableMode:   envoybandwidthlimitv3.BandwidthLimit_REQUEST,
+				LimitKbps:    wrapperspb.UInt64(512),
+				FillInterval: durationpb.New(100 * time.Millisecond),
+				RuntimeEnabled: &envoycorev3.RuntimeFeatureFlag{
+					DefaultValue: wrapperspb.Bool(true),
+					RuntimeKey:   "bandwidth_limit.enabled",
+				},
+			},
+			wantResponse: &envoybandwidthlimitv3.BandwidthLimit{
+				StatPrefix:   bandwidthLimitResponseStatPrefix,
+				EnableMode:   envoybandwidthlimitv3.BandwidthLimit_RESPONSE,
+				LimitKbps:    wrapperspb.UInt64(1024),
+				FillInterval: durationpb.New(100 * time.Millisecond),
+				RuntimeEnabled: &envoycorev3.RuntimeFeatureFlag{
+					DefaultValue: wrapperspb.Bool(true),
+					RuntimeKey:   "bandwidth_limit.enabled",
+				},
+			},
+		},
+		{
+			name: "response limit only",
+			spec: &kgateway.BandwidthLimit{
+				ResponseLimitKiBps: ptr.To(int32(64)),
+			},
+			wantResponse: &envoybandwidthlimitv3.BandwidthLimit{
+				StatPrefix: bandwidthLimitResponseStatPrefix,
+				EnableMode: envoybandwidthlimitv3.BandwidthLimit_RESPONSE,
+				LimitKbps:  wrapperspb.UInt64(64),
+			},
+		},
+		{
+			name: "disable",
+			spec: &kgateway.BandwidthLimit{
+				Disable: &shared.PolicyDisable{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			constructBandwidthLimit(kgateway.TrafficPolicySpec{BandwidthLimit: tt.spec}, out)
+			require.NotNil(t, out.bandwidthLimit)
+			require.NoError(t, out.bandwidthLimit.Validate())
+			assert.True(t, proto.Equal(tt.wantRequest, out.bandwidthLimit.request), "unexpected request limit: %v", out.bandwidthLimit.request)
+			assert.True(t, proto.Equal(tt.wantResponse, out.bandwidthLimit.response), "unexpected response limit: %v", out.bandwidthLimit.response)
+		})
+	}
+
+	t.Run("nil bandwidth limit", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		constructBandwidthLimit(kgateway.TrafficPolicySpec{}, out)
+		assert.Nil(t, out.bandwidthLimit)
+	})
+}
+
+func TestHandleBandwidthLimit(t *testing.T) {
+	requestLimit := &envoybandwidthlimitv3.BandwidthLimit{
+		StatPrefix: bandwidthLimitRequestStatPrefix,
+		EnableMode: envoybandwidthlimitv3.BandwidthLimit_REQUEST,
+		LimitKbps:  wrapperspb.UInt64(512),
+	}
+
+	t.Run("limit is set on the route and the filters are added to the chain", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleBandwidthLimit("fc", &typedFilterConfig, &bandwidthLimitIR{request: requestLimit})
+
+		assert.True(t, proto.Equal(requestLimit, typedFilterConfig[bandwidthLimitRequestFilterName]))
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig[bandwidthLimitResponseFilterName]))
+		assert.True(t, pass.bandwidthLimitInChain["fc"])
+	})
+
+	t.Run("disable disables both filters on the route", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleBandwidthLimit("fc", &typedFilterConfig, &bandwidthLimitIR{})
+
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig[bandwidthLimitRequestFilterName]))
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig[bandwidthLimitResponseFilterName]))
+		assert.False(t, pass.bandwidthLimitInChain["fc"])
+	})
+}
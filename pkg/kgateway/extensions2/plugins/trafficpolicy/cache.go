@@ -0,0 +1,257 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoysimplecachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	// cacheFilterPrefix is the prefix for the cache filter names
+	cacheFilterPrefix = "cache/"
+
+	// cacheGlobalDisableFilterName is the name of the filter for cache that disables all cache filters
+	cacheGlobalDisableFilterName = "global_disable/cache"
+
+	// cacheGlobalDisableFilterMetadataNamespace is the metadata namespace for the global disable cache filter
+	cacheGlobalDisableFilterMetadataNamespace = "dev.kgateway.disable_cache"
+)
+
+// cacheFilterStage runs the cache after requests have been authorized and rate limited,
+// so cached responses are only served to requests that would have been forwarded upstream.
+var cacheFilterStage = filters.BeforeStage(filters.AcceptedStage)
+
+// cacheIR is the cache configuration for the policy targets.
+// The Envoy cache filter does not support per-route configuration, so every unique
+// cache configuration runs in its own filter that is enabled on the routes using it.
+type cacheIR struct {
+	// filterName is the unique name of the filter running this cache configuration
+	filterName string
+	filter     *envoymatchingv3.ExtensionWithMatcher
+	disableAll bool
+
+	// policy is the TrafficPolicy the cache configuration comes from. It is used to report
+	// the routes that can never be cached on the status of the policy.
+	policy     reporter.PolicyKey
+	generation int64
+}
+
+var _ PolicySubIR = &cacheIR{}
+
+func (c *cacheIR) Equals(other PolicySubIR) bool {
+	otherCache, ok := other.(*cacheIR)
+	if !ok {
+		return false
+	}
+	if c == nil || otherCache == nil {
+		return c == nil && otherCache == nil
+	}
+	return c.filterName == otherCache.filterName &&
+		c.disableAll == otherCache.disableAll &&
+		c.policy == otherCache.policy &&
+		c.generation == otherCache.generation &&
+		proto.Equal(c.filter, otherCache.filter)
+}
+
+func (c *cacheIR) Validate() error {
+	if c == nil || c.filter == nil {
+		return nil
+	}
+	return c.filter.ValidateAll()
+}
+
+// constructCache constructs the cache policy IR from the policy specification.
+func constructCache(in *kgateway.TrafficPolicy, out *trafficPolicySpecIr) {
+	spec := in.Spec.Cache
+	if spec == nil {
+		return
+	}
+
+	policyKey := reporter.PolicyKey{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: in.GetNamespace(),
+		Name:      in.GetName(),
+	}
+
+	if spec.Disable != nil {
+		out.cache = &cacheIR{
+			disableAll: true,
+			policy:     policyKey,
+			generation: in.GetGeneration(),
+		}
+		return
+	}
+
+	cacheConfig := buildCacheConfig(spec)
+	out.cache = &cacheIR{
+		filterName: fmt.Sprintf("%s%016x", cacheFilterPrefix, utils.HashProto(cacheConfig)),
+		filter: buildCompositeFilter(
+			"composite_cache",
+			cacheGlobalDisableFilterMetadataNamespace,
+			&envoycorev3.TypedExtensionConfig{
+				Name:        "envoy.filters.http.cache",
+				TypedConfig: utils.MustMessageToAny(cacheConfig),
+			},
+		),
+		policy:     policyKey,
+		generation: in.GetGeneration(),
+	}
+}
+
+func buildCacheConfig(spec *kgateway.Cache) *envoycachev3.CacheConfig {
+	cacheConfig := &envoycachev3.CacheConfig{
+		TypedConfig: utils.MustMessageToAny(&envoysimplecachev3.SimpleHttpCacheConfig{}),
+	}
+
+	for _, header := range spec.VaryHeaders {
+		cacheConfig.AllowedVaryHeaders = append(cacheConfig.AllowedVaryHeaders, &envoymatcherv3.StringMatcher{
+			MatchPattern: &envoymatcherv3.StringMatcher_Exact{
+				Exact: string(header),
+			},
+			IgnoreCase: true,
+		})
+	}
+
+	if key := spec.CacheKey; key != nil {
+		params := &envoycachev3.CacheConfig_KeyCreatorParams{
+			ExcludeScheme: key.ExcludeScheme,
+			ExcludeHost:   key.ExcludeHost,
+		}
+		if qp := key.QueryParameters; qp != nil {
+			params.QueryParametersIncluded = toQueryParameterMatchers(qp.Include)
+			params.QueryParametersExcluded = toQueryParameterMatchers(qp.Exclude)
+		}
+		cacheConfig.KeyCreatorParams = params
+	}
+
+	if spec.MaxBodySize != nil {
+		maxBodySize := spec.MaxBodySize.Value()
+		if maxBodySize < 0 || maxBodySize > math.MaxUint32 {
+			maxBodySize = math.MaxUint32
+		}
+		cacheConfig.MaxBodyBytes = uint32(maxBodySize) //nolint:gosec // G115: validated above
+	}
+
+	return cacheConfig
+}
+
+func toQueryParameterMatchers(names []string) []*envoyroutev3.QueryParameterMatcher {
+	var matchers []*envoyroutev3.QueryParameterMatcher
+	for _, name := range names {
+		matchers = append(matchers, &envoyroutev3.QueryParameterMatcher{
+			Name: name,
+			QueryParameterMatchSpecifier: &envoyroutev3.QueryParameterMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		})
+	}
+	return matchers
+}
+
+func (p *trafficPolicyPluginGwPass) handleCache(filterChain string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, in *cacheIR) {
+	if in == nil {
+		return
+	}
+
+	// Enable the global disable filter to skip all cache filters
+	if in.disableAll {
+		pCtxTypedFilterConfig.AddTypedConfig(cacheGlobalDisableFilterName, EnableFilterPerRoute())
+		return
+	}
+
+	if p.cacheInChain == nil {
+		p.cacheInChain = make(map[string]map[string]*cacheIR)
+	}
+	if p.cacheInChain[filterChain] == nil {
+		p.cacheInChain[filterChain] = make(map[string]*cacheIR)
+	}
+	if _, ok := p.cacheInChain[filterChain][in.filterName]; !ok {
+		p.cacheInChain[filterChain][in.filterName] = in
+	}
+	pCtxTypedFilterConfig.AddTypedConfig(in.filterName, EnableFilterPerRoute())
+}
+
+// reportUncacheableRoute reports a condition on the status of the cache policy when the route
+// it is applied to can never be cached.
+func (p *trafficPolicyPluginGwPass) reportUncacheableRoute(pCtx *ir.RouteContext, outputRoute *envoyroutev3.Route, in *cacheIR) {
+	if in == nil || in.disableAll || p.reporter == nil {
+		return
+	}
+	reason := uncacheableRouteReason(pCtx.In, outputRoute)
+	if reason == "" {
+		return
+	}
+	if pCtx.In.Parent != nil {
+		reason = fmt.Sprintf("HTTPRoute %s: %s", pCtx.In.Parent.NamespacedName(), reason)
+	}
+
+	key := uncacheableRoutesKey{
+		policy:   in.policy,
+		ancestor: parentRefString(pCtx.PolicyAncestorRef),
+	}
+	if p.uncacheableRoutes == nil {
+		p.uncacheableRoutes = make(map[uncacheableRoutesKey][]string)
+	}
+	if slices.Contains(p.uncacheableRoutes[key], reason) {
+		return
+	}
+	p.uncacheableRoutes[key] = append(p.uncacheableRoutes[key], reason)
+
+	p.reporter.Policy(in.policy, in.generation).AncestorRef(pCtx.PolicyAncestorRef).SetCondition(reporter.PolicyCondition{
+		Type:               string(kgateway.TrafficPolicyConditionCacheable),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(kgateway.TrafficPolicyReasonUncacheableRoute),
+		Message:            "responses are never cached for " + strings.Join(p.uncacheableRoutes[key], "; "),
+		ObservedGeneration: in.generation,
+	})
+}
+
+// uncacheableRouteReason returns why responses of the route can never be cached, or an
+// empty string if they can be.
+func uncacheableRouteReason(in ir.HttpRouteRuleMatchIR, outputRoute *envoyroutev3.Route) string {
+	switch outputRoute.GetAction().(type) {
+	case *envoyroutev3.Route_Redirect:
+		return "the route redirects requests instead of forwarding them upstream"
+	case *envoyroutev3.Route_DirectResponse:
+		return "the route responds directly instead of forwarding requests upstream"
+	}
+	if method := in.Match.Method; method != nil && *method != gwv1.HTTPMethodGet && *method != gwv1.HTTPMethodHead {
+		return fmt.Sprintf("the route only matches %s requests; only GET and HEAD responses can be cached", *method)
+	}
+	return ""
+}
+
+type uncacheableRoutesKey struct {
+	policy   reporter.PolicyKey
+	ancestor string
+}
+
+func parentRefString(ref gwv1.ParentReference) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s",
+		ptr.Deref(ref.Group, ""),
+		ptr.Deref(ref.Kind, ""),
+		ptr.Deref(ref.Namespace, ""),
+		ref.Name,
+		ptr.Deref(ref.SectionName, ""),
+	)
+}
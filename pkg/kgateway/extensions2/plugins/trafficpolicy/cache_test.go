@@ -0,0 +1,199 @@
+package trafficpolicy
+
+import (
+	"strings"
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestConstructCache(t *testing.T) {
+	policy := func(cache *kgateway.Cache) *kgateway.TrafficPolicy {
+		return &kgateway.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default", Generation: 2},
+			Spec:       kgateway.TrafficPolicySpec{Cache: cache},
+		}
+	}
+	cacheConfig := func(t *testing.T, in *cacheIR) *envoycachev3.CacheConfig {
+		t.Helper()
+		action := in.filter.GetXdsMatcher().GetMatcherList().GetMatchers()[0].GetOnMatch().GetAction()
+		executeAction := &envoycompositev3.ExecuteFilterAction{}
+		require.NoError(t, action.GetTypedConfig().UnmarshalTo(executeAction))
+		out := &envoycachev3.CacheConfig{}
+		require.NoError(t, executeAction.GetTypedConfig().GetTypedConfig().UnmarshalTo(out))
+		return out
+	}
+
+	t.Run("full configuration", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		constructCache(policy(&kgateway.Cache{
+			VaryHeaders: []gwv1.HTTPHeaderName{"Accept-Language"},
+			CacheKey: &kgateway.CacheKey{
+				ExcludeHost: true,
+				QueryParameters: &kgateway.CacheKeyQueryParameters{
+					Include: []string{"page", "sort"},
+				},
+			},
+			MaxBodySize: ptr.To(resource.MustParse("1Mi")),
+		}), out)
+		require.NotNil(t, out.cache)
+		require.NoError(t, out.cache.Validate())
+		assert.False(t, out.cache.disableAll)
+		assert.Equal(t, "policy", out.cache.policy.Name)
+		assert.Equal(t, int64(2), out.cache.generation)
+
+		got := cacheConfig(t, out.cache)
+		require.Len(t, got.GetAllowedVaryHeaders(), 1)
+		assert.Equal(t, "Accept-Language", got.GetAllowedVaryHeaders()[0].GetExact())
+		assert.True(t, got.GetAllowedVaryHeaders()[0].GetIgnoreCase())
+		assert.True(t, got.GetKeyCreatorParams().GetExcludeHost())
+		assert.False(t, got.GetKeyCreatorParams().GetExcludeScheme())
+		require.Len(t, got.GetKeyCreatorParams().GetQueryParametersIncluded(), 2)
+		assert.Equal(t, "page", got.GetKeyCreatorParams().GetQueryParametersIncluded()[0].GetName())
+		assert.Empty(t, got.GetKeyCreatorParams().GetQueryParametersExcluded())
+		assert.Equal(t, uint32(1024*1024), got.GetMaxBodyBytes())
+	})
+
+	t.Run("same configuration yields the same filter name", func(t *testing.T) {
+		out1, out2, out3 := &trafficPolicySpecIr{}, &trafficPolicySpecIr{}, &trafficPolicySpecIr{}
+		constructCache(policy(&kgateway.Cache{VaryHeaders: []gwv1.HTTPHeaderName{"Accept"}}), out1)
+		constructCache(policy(&kgateway.Cache{VaryHeaders: []gwv1.HTTPHeaderName{"Accept"}}), out2)
+		constructCache(policy(&kgateway.Cache{}), out3)
+		assert.Equal(t, out1.cache.filterName, out2.cache.filterName)
+		assert.NotEqual(t, out1.cache.filterName, out3.cache.filterName)
+		assert.True(t, out1.cache.Equals(out2.cache))
+		assert.False(t, out1.cache.Equals(out3.cache))
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		constructCache(policy(&kgateway.Cache{Disable: &shared.PolicyDisable{}}), out)
+		require.NotNil(t, out.cache)
+		assert.True(t, out.cache.disableAll)
+		assert.Nil(t, out.cache.filter)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		constructCache(policy(nil), out)
+		assert.Nil(t, out.cache)
+	})
+}
+
+func TestHandleCache(t *testing.T) {
+	t.Run("cache is enabled on the route and added to the chain", func(t *testing.T) {
+		cache := &cacheIR{filterName: "cache/0000000000000001"}
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleCache("fc", &typedFilterConfig, cache)
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[cache.filterName]))
+		assert.Same(t, cache, pass.cacheInChain["fc"][cache.filterName])
+	})
+
+	t.Run("disable enables the global disable filter", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleCache("fc", &typedFilterConfig, &cacheIR{disableAll: true})
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[cacheGlobalDisableFilterName]))
+		assert.Empty(t, pass.cacheInChain["fc"])
+	})
+}
+
+func TestUncacheableRouteReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      *gwv1.HTTPMethod
+		route       *envoyroutev3.Route
+		uncacheable bool
+	}{
+		{
+			name: "any method",
+		},
+		{
+			name:   "GET",
+			method: ptr.To(gwv1.HTTPMethodGet),
+		},
+		{
+			name:   "HEAD",
+			method: ptr.To(gwv1.HTTPMethodHead),
+		},
+		{
+			name:        "POST",
+			method:      ptr.To(gwv1.HTTPMethodPost),
+			uncacheable: true,
+		},
+		{
+			name:        "redirect",
+			route:       &envoyroutev3.Route{Action: &envoyroutev3.Route_Redirect{}},
+			uncacheable: true,
+		},
+		{
+			name:        "direct response",
+			route:       &envoyroutev3.Route{Action: &envoyroutev3.Route_DirectResponse{}},
+			uncacheable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := ir.HttpRouteRuleMatchIR{Match: gwv1.HTTPRouteMatch{Method: tt.method}}
+			assert.Equal(t, tt.uncacheable, uncacheableRouteReason(in, tt.route) != "")
+		})
+	}
+}
+
+func TestReportUncacheableRoute(t *testing.T) {
+	cache := &cacheIR{filterName: "cache/0000000000000001"}
+	cache.policy.Name = "policy"
+	cache.policy.Namespace = "default"
+	ancestor := gwv1.ParentReference{Name: "gw"}
+	routeCtx := func(routeName string, method gwv1.HTTPMethod) *ir.RouteContext {
+		return &ir.RouteContext{
+			PolicyAncestorRef: ancestor,
+			In: ir.HttpRouteRuleMatchIR{
+				Parent: &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{Namespace: "default", Name: routeName}},
+				Match:  gwv1.HTTPRouteMatch{Method: ptr.To(method)},
+			},
+		}
+	}
+
+	reportMap := reports.NewReportMap()
+	pass := &trafficPolicyPluginGwPass{reporter: reports.NewReporter(&reportMap)}
+	pass.reportUncacheableRoute(routeCtx("get", gwv1.HTTPMethodGet), &envoyroutev3.Route{}, cache)
+	require.Nil(t, reportMap.Policies[cache.policy])
+
+	pass.reportUncacheableRoute(routeCtx("create", gwv1.HTTPMethodPost), &envoyroutev3.Route{}, cache)
+	pass.reportUncacheableRoute(routeCtx("delete", gwv1.HTTPMethodDelete), &envoyroutev3.Route{}, cache)
+	// the same route matched again must not be reported twice
+	pass.reportUncacheableRoute(routeCtx("delete", gwv1.HTTPMethodDelete), &envoyroutev3.Route{}, cache)
+
+	policyReport := reportMap.Policies[cache.policy]
+	require.NotNil(t, policyReport)
+	require.Len(t, policyReport.Ancestors, 1)
+	for _, ancestorReport := range policyReport.Ancestors {
+		cond := meta.FindStatusCondition(ancestorReport.Conditions, string(kgateway.TrafficPolicyConditionCacheable))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(kgateway.TrafficPolicyReasonUncacheableRoute), cond.Reason)
+		assert.Contains(t, cond.Message, "HTTPRoute default/create")
+		assert.Contains(t, cond.Message, "HTTPRoute default/delete")
+		assert.Equal(t, 1, strings.Count(cond.Message, "HTTPRoute default/delete"))
+	}
+}
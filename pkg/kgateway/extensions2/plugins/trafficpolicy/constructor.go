@@ -66,7 +66,9 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 		errors = append(errors, err)
 	}
 	// Construct local rate limit specific IR
-	constructLocalRateLimit(policyCR, &outSpec)
+	if err := constructLocalRateLimit(policyCR, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 	// Construct global rate limit specific IR
 	if err := constructGlobalRateLimit(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
 		errors = append(errors, err)
@@ -87,7 +89,9 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	// Construct buffer specific IR
 	constructBuffer(policyCR.Spec, &outSpec)
 	// Construct timeout and retry specific IR
-	constructTimeoutRetry(policyCR.Spec, &outSpec)
+	if err := constructTimeoutRetry(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 
 	// Construct rbac specific IR
 	if err := constructRBAC(policyCR, &outSpec); err != nil {
@@ -119,6 +123,42 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	if err := constructBasicAuth(krtctx, policyCR, &outSpec, c.commoncol.Secrets); err != nil {
 		errors = append(errors, err)
 	}
+	// Construct fault injection specific IR
+	if err := constructFaultInjection(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct wasm specific IR
+	if err := constructWasm(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct cache specific IR
+	constructCache(policyCR, &outSpec)
+	// Construct bandwidth limit specific IR
+	constructBandwidthLimit(policyCR.Spec, &outSpec)
+	// Construct error pages specific IR
+	if err := constructErrorPages(krtctx, policyCR, &outSpec, c.commoncol.ConfigMaps.Collection()); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct adaptive concurrency specific IR
+	if err := constructAdaptiveConcurrency(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct admission control specific IR
+	if err := constructAdmissionControl(policyCR, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct network authorization specific IR.
+	// Connections of TCPRoutes and TLSRoutes are denied rather than forwarded without it.
+	if err := constructNetworkAuthorization(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, ir.DenyConnectionsError{Err: err})
+	}
+	// Construct udp session specific IR
+	constructUDPSession(policyCR.Spec, &outSpec)
+	// Construct redis proxy specific IR.
+	// Connections of TCPRoutes are denied rather than forwarded without it.
+	if err := constructRedisProxy(krtctx, policyCR, c.commoncol, &outSpec); err != nil {
+		errors = append(errors, ir.DenyConnectionsError{Err: err})
+	}
 
 	for _, err := range errors {
 		logger.Error("error translating traffic policy", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
@@ -0,0 +1,313 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	envoyaccesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	celv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/filters/cel/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	celAccessLogFilterName = "envoy.access_loggers.extension_filters.cel"
+
+	errorPagesDefaultContentType = "text/plain"
+)
+
+type errorPagesIR struct {
+	// mappers are the local reply mappers of the rules, in order. Their filters match the local replies
+	// of the rules only, and are scoped to the targets of the policy during translation.
+	// Nil mappers disable error pages for the policy targets.
+	mappers []*envoy_hcm.ResponseMapper
+}
+
+var _ PolicySubIR = &errorPagesIR{}
+
+func (e *errorPagesIR) Equals(other PolicySubIR) bool {
+	otherErrorPages, ok := other.(*errorPagesIR)
+	if !ok {
+		return false
+	}
+	if e == nil || otherErrorPages == nil {
+		return e == nil && otherErrorPages == nil
+	}
+	return slices.EqualFunc(e.mappers, otherErrorPages.mappers, func(a, b *envoy_hcm.ResponseMapper) bool {
+		return proto.Equal(a, b)
+	})
+}
+
+func (e *errorPagesIR) Validate() error {
+	if e == nil {
+		return nil
+	}
+	for _, mapper := range e.mappers {
+		if err := mapper.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errorPagesMappers are the local reply mappers of the error pages policies applied to a filter chain,
+// by the scope of the policies. Local reply mappers are evaluated in order and the first matching mapper
+// is applied, so the mappers of the most specific scope come first.
+type errorPagesMappers struct {
+	routes       []*envoy_hcm.ResponseMapper
+	virtualHosts []*envoy_hcm.ResponseMapper
+	routeConfig  []*envoy_hcm.ResponseMapper
+}
+
+func (m *errorPagesMappers) all() []*envoy_hcm.ResponseMapper {
+	return slices.Concat(m.routes, m.virtualHosts, m.routeConfig)
+}
+
+// constructErrorPages constructs the error pages policy IR from the policy specification.
+func constructErrorPages(
+	krtctx krt.HandlerContext,
+	in *kgateway.TrafficPolicy,
+	out *trafficPolicySpecIr,
+	configMaps krt.Collection[*corev1.ConfigMap],
+) error {
+	spec := in.Spec.ErrorPages
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.errorPages = &errorPagesIR{}
+		return nil
+	}
+
+	var mappers []*envoy_hcm.ResponseMapper
+	for i, rule := range spec.Rules {
+		mapper, err := toResponseMapper(krtctx, in.Namespace, rule, configMaps)
+		if err != nil {
+			return fmt.Errorf("errorPages rule %d: %w", i, err)
+		}
+		mappers = append(mappers, mapper)
+	}
+
+	out.errorPages = &errorPagesIR{
+		mappers: mappers,
+	}
+	return nil
+}
+
+func toResponseMapper(
+	krtctx krt.HandlerContext,
+	ns string,
+	rule kgateway.ErrorPageRule,
+	configMaps krt.Collection[*corev1.ConfigMap],
+) (*envoy_hcm.ResponseMapper, error) {
+	mapper := &envoy_hcm.ResponseMapper{
+		Filter: errorPageRuleFilter(rule),
+	}
+	if rule.StatusCode != nil {
+		mapper.StatusCode = wrapperspb.UInt32(uint32(*rule.StatusCode)) // nolint:gosec // G115: kubebuilder validation ensures the value is a valid status code
+	}
+	for _, header := range rule.Headers {
+		mapper.HeadersToAdd = append(mapper.HeadersToAdd, &envoycorev3.HeaderValueOption{
+			Header: &envoycorev3.HeaderValue{
+				Key:   string(header.Name),
+				Value: header.Value,
+			},
+			AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+
+	if rule.Body == nil {
+		return mapper, nil
+	}
+	var body string
+	switch {
+	case rule.Body.Inline != nil:
+		body = *rule.Body.Inline
+	case rule.Body.ConfigMap != nil:
+		cm, err := GetConfigMap(krtctx, configMaps, rule.Body.ConfigMap.Name, ns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find configmap %s: %w", rule.Body.ConfigMap.Name, err)
+		}
+		data, ok := cm.Data[rule.Body.ConfigMap.Key]
+		if !ok {
+			return nil, fmt.Errorf("configmap %s does not contain key %s", rule.Body.ConfigMap.Name, rule.Body.ConfigMap.Key)
+		}
+		body = data
+	}
+
+	contentType := errorPagesDefaultContentType
+	if rule.ContentType != nil {
+		contentType = *rule.ContentType
+	}
+	// The body is used as the format string, so that it supports format substitution
+	mapper.BodyFormatOverride = &envoycorev3.SubstitutionFormatString{
+		Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+			TextFormatSource: &envoycorev3.DataSource{
+				Specifier: &envoycorev3.DataSource_InlineString{InlineString: body},
+			},
+		},
+		ContentType: contentType,
+	}
+	return mapper, nil
+}
+
+// errorPageRuleFilter returns a filter matching the local replies with any of the status codes
+// and any of the response flags of the rule.
+func errorPageRuleFilter(rule kgateway.ErrorPageRule) *envoyaccesslogv3.AccessLogFilter {
+	var statusCodeFilters []*envoyaccesslogv3.AccessLogFilter
+	for _, code := range rule.StatusCodes {
+		statusCodeFilters = append(statusCodeFilters, &envoyaccesslogv3.AccessLogFilter{
+			FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoyaccesslogv3.StatusCodeFilter{
+					Comparison: &envoyaccesslogv3.ComparisonFilter{
+						Op: envoyaccesslogv3.ComparisonFilter_EQ,
+						Value: &envoycorev3.RuntimeUInt32{
+							DefaultValue: uint32(code), // nolint:gosec // G115: kubebuilder validation ensures the value is a valid status code
+						},
+					},
+				},
+			},
+		})
+	}
+
+	var filters []*envoyaccesslogv3.AccessLogFilter
+	if len(statusCodeFilters) > 0 {
+		filters = append(filters, orAccessLogFilter(statusCodeFilters))
+	}
+	if len(rule.ResponseFlags) > 0 {
+		flags := make([]string, 0, len(rule.ResponseFlags))
+		for _, flag := range rule.ResponseFlags {
+			flags = append(flags, string(flag))
+		}
+		filters = append(filters, &envoyaccesslogv3.AccessLogFilter{
+			FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &envoyaccesslogv3.ResponseFlagFilter{
+					Flags: flags,
+				},
+			},
+		})
+	}
+	return andAccessLogFilter(filters)
+}
+
+// scopedErrorPageMappers returns the mappers of the error pages restricted to the local replies matching the
+// scope filter, followed by a mapper leaving the other local replies of the scope unchanged, so that error pages
+// of a less specific scope do not apply. A nil scope filter returns the mappers unchanged.
+func scopedErrorPageMappers(errorPages *errorPagesIR, scope *envoyaccesslogv3.AccessLogFilter) []*envoy_hcm.ResponseMapper {
+	if scope == nil {
+		return errorPages.mappers
+	}
+	mappers := make([]*envoy_hcm.ResponseMapper, 0, len(errorPages.mappers)+1)
+	for _, mapper := range errorPages.mappers {
+		scoped := proto.Clone(mapper).(*envoy_hcm.ResponseMapper)
+		scoped.Filter = andAccessLogFilter([]*envoyaccesslogv3.AccessLogFilter{scope, mapper.GetFilter()})
+		mappers = append(mappers, scoped)
+	}
+	return append(mappers, &envoy_hcm.ResponseMapper{Filter: scope})
+}
+
+// celAccessLogFilter returns a filter matching the local replies for which the CEL expression is true.
+func celAccessLogFilter(expression string) *envoyaccesslogv3.AccessLogFilter {
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_ExtensionFilter{
+			ExtensionFilter: &envoyaccesslogv3.ExtensionFilter{
+				Name: celAccessLogFilterName,
+				ConfigType: &envoyaccesslogv3.ExtensionFilter_TypedConfig{
+					TypedConfig: utils.MustMessageToAny(&celv3.ExpressionFilter{
+						Expression: expression,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func orAccessLogFilter(filters []*envoyaccesslogv3.AccessLogFilter) *envoyaccesslogv3.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_OrFilter{
+			OrFilter: &envoyaccesslogv3.OrFilter{Filters: filters},
+		},
+	}
+}
+
+func andAccessLogFilter(filters []*envoyaccesslogv3.AccessLogFilter) *envoyaccesslogv3.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_AndFilter{
+			AndFilter: &envoyaccesslogv3.AndFilter{Filters: filters},
+		},
+	}
+}
+
+// handleErrorPages collects the local reply mappers of the error pages applied to the route configuration
+// of a filter chain, i.e. to the Gateway or listener. They apply to all the local replies of the filter chain.
+func (p *trafficPolicyPluginGwPass) handleErrorPages(fcn string, errorPages *errorPagesIR) {
+	if errorPages == nil {
+		return
+	}
+	m := p.errorPagesMappers(fcn)
+	m.routeConfig = append(m.routeConfig, scopedErrorPageMappers(errorPages, nil)...)
+}
+
+// handleVhostErrorPages collects the local reply mappers of the error pages applied to a virtual host.
+func (p *trafficPolicyPluginGwPass) handleVhostErrorPages(fcn, vhostName string, errorPages *errorPagesIR) {
+	if errorPages == nil {
+		return
+	}
+	scope := celAccessLogFilter("xds.virtual_host_name == " + strconv.Quote(vhostName))
+	m := p.errorPagesMappers(fcn)
+	m.virtualHosts = append(m.virtualHosts, scopedErrorPageMappers(errorPages, scope)...)
+}
+
+// handleRouteErrorPages collects the local reply mappers of the error pages applied to a route.
+func (p *trafficPolicyPluginGwPass) handleRouteErrorPages(fcn, routeName string, errorPages *errorPagesIR) {
+	if errorPages == nil {
+		return
+	}
+	scope := celAccessLogFilter("xds.route_name == " + strconv.Quote(routeName))
+	m := p.errorPagesMappers(fcn)
+	m.routes = append(m.routes, scopedErrorPageMappers(errorPages, scope)...)
+}
+
+func (p *trafficPolicyPluginGwPass) errorPagesMappers(fcn string) *errorPagesMappers {
+	if p.errorPagesInChain == nil {
+		p.errorPagesInChain = make(map[string]*errorPagesMappers)
+	}
+	m, ok := p.errorPagesInChain[fcn]
+	if !ok {
+		m = &errorPagesMappers{}
+		p.errorPagesInChain[fcn] = m
+	}
+	return m
+}
+
+// FinalizeHCM sets the local reply config of the HCM to serve the error pages applied to the filter chain.
+func (p *trafficPolicyPluginGwPass) FinalizeHCM(_ ir.HttpFiltersContext, fcc ir.FilterChainCommon, out *envoy_hcm.HttpConnectionManager) error {
+	m, ok := p.errorPagesInChain[fcc.FilterChainName]
+	if !ok {
+		return nil
+	}
+	mappers := m.all()
+	if len(mappers) == 0 {
+		return nil
+	}
+	out.LocalReplyConfig = &envoy_hcm.LocalReplyConfig{
+		Mappers: mappers,
+	}
+	return nil
+}
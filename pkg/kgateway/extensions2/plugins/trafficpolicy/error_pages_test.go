@@ -0,0 +1,196 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyaccesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	celv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/filters/cel/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructErrorPages(t *testing.T) {
+	mock := krttest.NewMock(t, []any{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "pages", Namespace: "default"},
+			Data:       map[string]string{"503.html": "<h1>unavailable</h1>"},
+		},
+	})
+	configMaps := krttest.GetMockCollection[*corev1.ConfigMap](mock)
+	configMaps.WaitUntilSynced(nil)
+
+	construct := func(spec *kgateway.ErrorPages) (*trafficPolicySpecIr, error) {
+		out := &trafficPolicySpecIr{}
+		err := constructErrorPages(krt.TestingDummyContext{}, &kgateway.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+			Spec:       kgateway.TrafficPolicySpec{ErrorPages: spec},
+		}, out, configMaps)
+		return out, err
+	}
+
+	t.Run("rules are translated to local reply mappers", func(t *testing.T) {
+		out, err := construct(&kgateway.ErrorPages{
+			Rules: []kgateway.ErrorPageRule{
+				{
+					StatusCodes:   []int32{502, 503},
+					ResponseFlags: []kgateway.ErrorPageResponseFlag{"UH", "UF"},
+					StatusCode:    ptr.To(int32(503)),
+					Body: &kgateway.ErrorPageBody{
+						ConfigMap: &kgateway.ErrorPageConfigMapSource{Name: "pages", Key: "503.html"},
+					},
+					ContentType: ptr.To("text/html"),
+				},
+				{
+					ResponseFlags: []kgateway.ErrorPageResponseFlag{"NR"},
+					Headers:       []gwv1.HTTPHeader{{Name: "x-error", Value: "not-found"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, out.errorPages)
+		require.NoError(t, out.errorPages.Validate())
+
+		mappers := out.errorPages.mappers
+		require.Len(t, mappers, 2)
+
+		filters := mappers[0].GetFilter().GetAndFilter().GetFilters()
+		require.Len(t, filters, 2)
+		statusCodeFilters := filters[0].GetOrFilter().GetFilters()
+		require.Len(t, statusCodeFilters, 2)
+		assert.Equal(t, uint32(502), statusCodeFilters[0].GetStatusCodeFilter().GetComparison().GetValue().GetDefaultValue())
+		assert.Equal(t, []string{"UH", "UF"}, filters[1].GetResponseFlagFilter().GetFlags())
+		assert.Equal(t, uint32(503), mappers[0].GetStatusCode().GetValue())
+		assert.Equal(t, "<h1>unavailable</h1>", mappers[0].GetBodyFormatOverride().GetTextFormatSource().GetInlineString())
+		assert.Equal(t, "text/html", mappers[0].GetBodyFormatOverride().GetContentType())
+
+		assert.Equal(t, []string{"NR"}, mappers[1].GetFilter().GetResponseFlagFilter().GetFlags())
+		assert.Nil(t, mappers[1].GetStatusCode())
+		assert.Nil(t, mappers[1].GetBodyFormatOverride())
+		require.Len(t, mappers[1].GetHeadersToAdd(), 1)
+		assert.Equal(t, "x-error", mappers[1].GetHeadersToAdd()[0].GetHeader().GetKey())
+	})
+
+	t.Run("inline body defaults to text/plain", func(t *testing.T) {
+		out, err := construct(&kgateway.ErrorPages{
+			Rules: []kgateway.ErrorPageRule{{
+				StatusCodes: []int32{429},
+				Body:        &kgateway.ErrorPageBody{Inline: ptr.To("slow down: %RESPONSE_CODE%")},
+			}},
+		})
+		require.NoError(t, err)
+
+		mappers := out.errorPages.mappers
+		require.Len(t, mappers, 1)
+		assert.Equal(t, uint32(429), mappers[0].GetFilter().GetStatusCodeFilter().GetComparison().GetValue().GetDefaultValue())
+		assert.Equal(t, "slow down: %RESPONSE_CODE%", mappers[0].GetBodyFormatOverride().GetTextFormatSource().GetInlineString())
+		assert.Equal(t, errorPagesDefaultContentType, mappers[0].GetBodyFormatOverride().GetContentType())
+	})
+
+	t.Run("missing configmap key", func(t *testing.T) {
+		_, err := construct(&kgateway.ErrorPages{
+			Rules: []kgateway.ErrorPageRule{{
+				StatusCodes: []int32{503},
+				Body: &kgateway.ErrorPageBody{
+					ConfigMap: &kgateway.ErrorPageConfigMapSource{Name: "pages", Key: "missing"},
+				},
+			}},
+		})
+		assert.ErrorContains(t, err, "does not contain key missing")
+	})
+
+	t.Run("missing configmap", func(t *testing.T) {
+		_, err := construct(&kgateway.ErrorPages{
+			Rules: []kgateway.ErrorPageRule{{
+				StatusCodes: []int32{503},
+				Body: &kgateway.ErrorPageBody{
+					ConfigMap: &kgateway.ErrorPageConfigMapSource{Name: "missing", Key: "503.html"},
+				},
+			}},
+		})
+		assert.ErrorContains(t, err, "failed to find configmap missing")
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		out, err := construct(&kgateway.ErrorPages{Disable: &shared.PolicyDisable{}})
+		require.NoError(t, err)
+		require.NotNil(t, out.errorPages)
+		assert.Nil(t, out.errorPages.mappers)
+	})
+
+	t.Run("nil error pages", func(t *testing.T) {
+		out, err := construct(nil)
+		require.NoError(t, err)
+		assert.Nil(t, out.errorPages)
+	})
+}
+
+func TestHandleErrorPages(t *testing.T) {
+	notFound := &envoy_hcm.ResponseMapper{
+		Filter:     errorPageRuleFilter(kgateway.ErrorPageRule{ResponseFlags: []kgateway.ErrorPageResponseFlag{"NR"}}),
+		StatusCode: wrapperspb.UInt32(404),
+	}
+	unavailable := &envoy_hcm.ResponseMapper{
+		Filter:     errorPageRuleFilter(kgateway.ErrorPageRule{ResponseFlags: []kgateway.ErrorPageResponseFlag{"UH"}}),
+		StatusCode: wrapperspb.UInt32(503),
+	}
+
+	t.Run("mappers are ordered from the most specific scope", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		pass.handleErrorPages("fc", &errorPagesIR{mappers: []*envoy_hcm.ResponseMapper{notFound}})
+		pass.handleRouteErrorPages("fc", "route", &errorPagesIR{mappers: []*envoy_hcm.ResponseMapper{unavailable}})
+		pass.handleVhostErrorPages("fc", "vhost", &errorPagesIR{})
+
+		hcm := &envoy_hcm.HttpConnectionManager{}
+		require.NoError(t, pass.FinalizeHCM(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "fc"}, hcm))
+		mappers := hcm.GetLocalReplyConfig().GetMappers()
+		require.Len(t, mappers, 4)
+		require.NoError(t, hcm.GetLocalReplyConfig().Validate())
+
+		// route scope: the rule restricted to the route, then the other local replies of the route are left unchanged
+		routeFilters := mappers[0].GetFilter().GetAndFilter().GetFilters()
+		require.Len(t, routeFilters, 2)
+		assert.Equal(t, `xds.route_name == "route"`, celExpression(t, routeFilters[0]))
+		assert.True(t, proto.Equal(unavailable.GetFilter(), routeFilters[1]))
+		assert.Equal(t, uint32(503), mappers[0].GetStatusCode().GetValue())
+		assert.Equal(t, `xds.route_name == "route"`, celExpression(t, mappers[1].GetFilter()))
+		assert.Nil(t, mappers[1].GetStatusCode())
+
+		// disabled vhost scope: the local replies of the vhost are left unchanged
+		assert.Equal(t, `xds.virtual_host_name == "vhost"`, celExpression(t, mappers[2].GetFilter()))
+		assert.Nil(t, mappers[2].GetStatusCode())
+
+		// gateway scope: all the local replies of the filter chain
+		assert.True(t, proto.Equal(notFound, mappers[3]))
+	})
+
+	t.Run("no local reply config without error pages", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		pass.handleErrorPages("fc", &errorPagesIR{})
+
+		hcm := &envoy_hcm.HttpConnectionManager{}
+		require.NoError(t, pass.FinalizeHCM(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "fc"}, hcm))
+		assert.Nil(t, hcm.GetLocalReplyConfig())
+		require.NoError(t, pass.FinalizeHCM(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "other"}, hcm))
+		assert.Nil(t, hcm.GetLocalReplyConfig())
+	})
+}
+
+func celExpression(t *testing.T, filter *envoyaccesslogv3.AccessLogFilter) string {
+	t.Helper()
+	expression := &celv3.ExpressionFilter{}
+	require.NoError(t, filter.GetExtensionFilter().GetTypedConfig().UnmarshalTo(expression))
+	return expression.GetExpression()
+}
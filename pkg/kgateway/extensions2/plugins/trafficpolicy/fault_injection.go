@@ -0,0 +1,167 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	envoycommonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoyfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+const faultFilterName = "envoy.filters.http.fault"
+
+type faultInjectionIR struct {
+	// fault is the per-route fault configuration. It is nil when fault injection is disabled.
+	fault *envoyfaultv3.HTTPFault
+}
+
+var _ PolicySubIR = &faultInjectionIR{}
+
+func (f *faultInjectionIR) Equals(other PolicySubIR) bool {
+	otherFault, ok := other.(*faultInjectionIR)
+	if !ok {
+		return false
+	}
+	if f == nil || otherFault == nil {
+		return f == nil && otherFault == nil
+	}
+	return proto.Equal(f.fault, otherFault.fault)
+}
+
+func (f *faultInjectionIR) Validate() error {
+	if f == nil || f.fault == nil {
+		return nil
+	}
+	return f.fault.Validate()
+}
+
+// constructFaultInjection constructs the fault injection policy IR from the policy specification.
+func constructFaultInjection(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.FaultInjection == nil {
+		return nil
+	}
+
+	if spec.FaultInjection.Disable != nil {
+		out.faultInjection = &faultInjectionIR{}
+		return nil
+	}
+
+	fault := &envoyfaultv3.HTTPFault{}
+	if delay := spec.FaultInjection.Delay; delay != nil {
+		fault.Delay = &envoycommonfaultv3.FaultDelay{
+			Percentage: toFaultPercentage(delay.Percentage),
+		}
+		if delay.FixedDelay != nil {
+			fault.Delay.FaultDelaySecifier = &envoycommonfaultv3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(delay.FixedDelay.Duration),
+			}
+		} else if delay.FromHeader != nil {
+			fault.Delay.FaultDelaySecifier = &envoycommonfaultv3.FaultDelay_HeaderDelay_{
+				HeaderDelay: &envoycommonfaultv3.FaultDelay_HeaderDelay{},
+			}
+		}
+	}
+
+	if abort := spec.FaultInjection.Abort; abort != nil {
+		fault.Abort = &envoyfaultv3.FaultAbort{
+			Percentage: toFaultPercentage(abort.Percentage),
+		}
+		switch {
+		case abort.HttpStatus != nil:
+			fault.Abort.ErrorType = &envoyfaultv3.FaultAbort_HttpStatus{
+				HttpStatus: uint32(*abort.HttpStatus), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			}
+		case abort.GrpcStatus != nil:
+			fault.Abort.ErrorType = &envoyfaultv3.FaultAbort_GrpcStatus{
+				GrpcStatus: uint32(*abort.GrpcStatus), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			}
+		case abort.FromHeader != nil:
+			fault.Abort.ErrorType = &envoyfaultv3.FaultAbort_HeaderAbort_{
+				HeaderAbort: &envoyfaultv3.FaultAbort_HeaderAbort{},
+			}
+		}
+	}
+
+	if rateLimit := spec.FaultInjection.ResponseRateLimit; rateLimit != nil {
+		fault.ResponseRateLimit = &envoycommonfaultv3.FaultRateLimit{
+			Percentage: toFaultPercentage(rateLimit.Percentage),
+		}
+		if rateLimit.Kbps != nil {
+			fault.ResponseRateLimit.LimitType = &envoycommonfaultv3.FaultRateLimit_FixedLimit_{
+				FixedLimit: &envoycommonfaultv3.FaultRateLimit_FixedLimit{
+					LimitKbps: uint64(*rateLimit.Kbps), // nolint:gosec // G115: kubebuilder validation ensures the value is positive
+				},
+			}
+		} else if rateLimit.FromHeader != nil {
+			fault.ResponseRateLimit.LimitType = &envoycommonfaultv3.FaultRateLimit_HeaderLimit_{
+				HeaderLimit: &envoycommonfaultv3.FaultRateLimit_HeaderLimit{},
+			}
+		}
+	}
+
+	if len(spec.FaultInjection.Headers) > 0 {
+		headers := make([]gwv1.HTTPHeaderMatch, 0, len(spec.FaultInjection.Headers))
+		for _, h := range spec.FaultInjection.Headers {
+			// the header match type defaults to Exact in the CRD schema
+			if h.Type == nil {
+				h.Type = ptr.To(gwv1.HeaderMatchExact)
+			}
+			headers = append(headers, h)
+		}
+		matchers, err := pluginsdkutils.ToEnvoyHeaderMatchers(headers)
+		if err != nil {
+			return fmt.Errorf("invalid fault injection headers: %w", err)
+		}
+		fault.Headers = matchers
+	}
+
+	if spec.FaultInjection.MaxActiveFaults != nil {
+		fault.MaxActiveFaults = wrapperspb.UInt32(uint32(*spec.FaultInjection.MaxActiveFaults)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+
+	out.faultInjection = &faultInjectionIR{
+		fault: fault,
+	}
+	return nil
+}
+
+// toFaultPercentage converts an optional percentage in the range [0, 100] to an
+// Envoy FractionalPercent, defaulting to 100 when unset.
+func toFaultPercentage(percentage *int32) *envoytypev3.FractionalPercent {
+	return &envoytypev3.FractionalPercent{
+		Numerator:   uint32(ptr.Deref(percentage, 100)), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		Denominator: envoytypev3.FractionalPercent_HUNDRED,
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleFaultInjection(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, fault *faultInjectionIR) {
+	if fault == nil {
+		return
+	}
+
+	if fault.fault == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	// Add fault configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, fault.fault)
+
+	// Add a filter to the chain. When having a fault policy for a route we need to also have a
+	// globally disabled fault filter in the chain otherwise it will be ignored.
+	if p.faultInChain == nil {
+		p.faultInChain = make(map[string]*envoyfaultv3.HTTPFault)
+	}
+	if _, ok := p.faultInChain[fcn]; !ok {
+		p.faultInChain[fcn] = &envoyfaultv3.HTTPFault{}
+	}
+}
@@ -0,0 +1,218 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycommonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoyfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructFaultInjection(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *kgateway.FaultInjection
+		want *faultInjectionIR
+	}{
+		{
+			name: "nil spec",
+		},
+		{
+			name: "disabled",
+			spec: &kgateway.FaultInjection{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &faultInjectionIR{},
+		},
+		{
+			name: "fixed delay and http abort",
+			spec: &kgateway.FaultInjection{
+				Delay: &kgateway.FaultDelay{
+					FixedDelay: &metav1.Duration{Duration: 2 * time.Second},
+					Percentage: ptr.To(int32(50)),
+				},
+				Abort: &kgateway.FaultAbort{
+					HttpStatus: ptr.To(int32(503)),
+				},
+				MaxActiveFaults: ptr.To(int32(10)),
+			},
+			want: &faultInjectionIR{
+				fault: &envoyfaultv3.HTTPFault{
+					Delay: &envoycommonfaultv3.FaultDelay{
+						FaultDelaySecifier: &envoycommonfaultv3.FaultDelay_FixedDelay{
+							FixedDelay: durationpb.New(2 * time.Second),
+						},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   50,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					Abort: &envoyfaultv3.FaultAbort{
+						ErrorType: &envoyfaultv3.FaultAbort_HttpStatus{HttpStatus: 503},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					MaxActiveFaults: wrapperspb.UInt32(10),
+				},
+			},
+		},
+		{
+			name: "header controlled faults",
+			spec: &kgateway.FaultInjection{
+				Delay: &kgateway.FaultDelay{
+					FromHeader: &kgateway.FaultFromHeader{},
+				},
+				Abort: &kgateway.FaultAbort{
+					FromHeader: &kgateway.FaultFromHeader{},
+				},
+				ResponseRateLimit: &kgateway.FaultResponseRateLimit{
+					FromHeader: &kgateway.FaultFromHeader{},
+				},
+			},
+			want: &faultInjectionIR{
+				fault: &envoyfaultv3.HTTPFault{
+					Delay: &envoycommonfaultv3.FaultDelay{
+						FaultDelaySecifier: &envoycommonfaultv3.FaultDelay_HeaderDelay_{
+							HeaderDelay: &envoycommonfaultv3.FaultDelay_HeaderDelay{},
+						},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					Abort: &envoyfaultv3.FaultAbort{
+						ErrorType: &envoyfaultv3.FaultAbort_HeaderAbort_{
+							HeaderAbort: &envoyfaultv3.FaultAbort_HeaderAbort{},
+						},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					ResponseRateLimit: &envoycommonfaultv3.FaultRateLimit{
+						LimitType: &envoycommonfaultv3.FaultRateLimit_HeaderLimit_{
+							HeaderLimit: &envoycommonfaultv3.FaultRateLimit_HeaderLimit{},
+						},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "grpc abort and response rate limit restricted by headers",
+			spec: &kgateway.FaultInjection{
+				Abort: &kgateway.FaultAbort{
+					GrpcStatus: ptr.To(int32(14)),
+					Percentage: ptr.To(int32(5)),
+				},
+				ResponseRateLimit: &kgateway.FaultResponseRateLimit{
+					Kbps: ptr.To(int32(64)),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Name:  "x-chaos",
+						Value: "true",
+					},
+				},
+			},
+			want: &faultInjectionIR{
+				fault: &envoyfaultv3.HTTPFault{
+					Abort: &envoyfaultv3.FaultAbort{
+						ErrorType: &envoyfaultv3.FaultAbort_GrpcStatus{GrpcStatus: 14},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   5,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					ResponseRateLimit: &envoycommonfaultv3.FaultRateLimit{
+						LimitType: &envoycommonfaultv3.FaultRateLimit_FixedLimit_{
+							FixedLimit: &envoycommonfaultv3.FaultRateLimit_FixedLimit{LimitKbps: 64},
+						},
+						Percentage: &envoytypev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoytypev3.FractionalPercent_HUNDRED,
+						},
+					},
+					Headers: []*envoyroutev3.HeaderMatcher{
+						{
+							Name: "x-chaos",
+							HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_StringMatch{
+								StringMatch: &envoymatcherv3.StringMatcher{
+									MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "true"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructFaultInjection(kgateway.TrafficPolicySpec{
+				FaultInjection: tt.spec,
+			}, out)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equals(out.faultInjection), "unexpected fault IR: %v", out.faultInjection)
+			require.NoError(t, out.faultInjection.Validate())
+		})
+	}
+}
+
+func TestHandleFaultInjection(t *testing.T) {
+	t.Run("enabled fault adds per-route config and disabled filter in chain", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructFaultInjection(kgateway.TrafficPolicySpec{
+			FaultInjection: &kgateway.FaultInjection{
+				Abort: &kgateway.FaultAbort{HttpStatus: ptr.To(int32(500))},
+			},
+		}, out)
+		require.NoError(t, err)
+
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleFaultInjection("fc", &typedFilterConfig, out.faultInjection)
+
+		assert.True(t, proto.Equal(out.faultInjection.fault, typedFilterConfig[faultFilterName]))
+		assert.NotNil(t, pass.faultInChain["fc"])
+	})
+
+	t.Run("disabled fault disables the filter on the route", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructFaultInjection(kgateway.TrafficPolicySpec{
+			FaultInjection: &kgateway.FaultInjection{
+				Disable: &shared.PolicyDisable{},
+			},
+		}, out)
+		require.NoError(t, err)
+
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleFaultInjection("fc", &typedFilterConfig, out.faultInjection)
+
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig[faultFilterName]))
+		assert.Nil(t, pass.faultInChain["fc"])
+	})
+}
@@ -17,6 +17,7 @@ import (
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoynetworkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
 	envoymetadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/metadata/v3"
+	envoywasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
@@ -24,10 +25,12 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -35,12 +38,17 @@ import (
 
 type TrafficPolicyGatewayExtensionIR struct {
 	// +krtEqualsTodo decide whether extension name should affect equality
-	Name             string
-	ExtAuth          *envoy_ext_authz_v3.ExtAuthz
-	ExtProc          *envoymatchingv3.ExtensionWithMatcher
-	RateLimit        *ratev3.RateLimit
+	Name      string
+	ExtAuth   *envoy_ext_authz_v3.ExtAuthz
+	ExtProc   *envoymatchingv3.ExtensionWithMatcher
+	RateLimit *ratev3.RateLimit
+	// BuiltinRateLimit is true when RateLimit uses the built-in rate limit service
+	BuiltinRateLimit bool
 	Jwt              *envoymatchingv3.ExtensionWithMatcher
 	OAuth2           *oauthPerProviderConfig
+	Wasm             *envoywasmv3.PluginConfig
+	// WasmImage is set when the Wasm module is distributed as an OCI image
+	WasmImage        *ir.WasmImage
 	PrecedenceWeight int32
 	Err              error
 }
@@ -60,12 +68,21 @@ func (e TrafficPolicyGatewayExtensionIR) Equals(other TrafficPolicyGatewayExtens
 	if !proto.Equal(e.RateLimit, other.RateLimit) {
 		return false
 	}
+	if e.BuiltinRateLimit != other.BuiltinRateLimit {
+		return false
+	}
 	if !proto.Equal(e.Jwt, other.Jwt) {
 		return false
 	}
 	if !e.OAuth2.Equals(other.OAuth2) {
 		return false
 	}
+	if !proto.Equal(e.Wasm, other.Wasm) {
+		return false
+	}
+	if !ptr.Equal(e.WasmImage, other.WasmImage) {
+		return false
+	}
 	if e.PrecedenceWeight != other.PrecedenceWeight {
 		return false
 	}
@@ -109,6 +126,11 @@ func (e TrafficPolicyGatewayExtensionIR) Validate() error {
 			return err
 		}
 	}
+	if e.Wasm != nil {
+		if err := e.Wasm.ValidateAll(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -189,10 +211,27 @@ func TranslateGatewayExtensionBuilder(
 			p.ExtProc = buildCompositeExtProcFilter(*gExt.ExtProc, envoyGrpcService)
 
 		case gExt.RateLimit != nil:
-			grpcService, err := ResolveExtGrpcService(krtctx, commoncol.BackendIndex, false, gExt.ObjectSource, &gExt.RateLimit.GrpcService)
-			if err != nil {
-				p.Err = fmt.Errorf("ratelimit: %w", err)
-				return p
+			var grpcService *envoycorev3.GrpcService
+			if gExt.RateLimit.Builtin != nil {
+				if !commoncol.Settings.EnableBuiltinRateLimit {
+					p.Err = errors.New("ratelimit: the built-in rate limit service is not enabled")
+					return p
+				}
+				grpcService = &envoycorev3.GrpcService{
+					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
+							ClusterName: wellknown.XdsClusterName,
+						},
+					},
+				}
+				p.BuiltinRateLimit = true
+			} else {
+				var err error
+				grpcService, err = ResolveExtGrpcService(krtctx, commoncol.BackendIndex, false, gExt.ObjectSource, &gExt.RateLimit.GrpcService)
+				if err != nil {
+					p.Err = fmt.Errorf("ratelimit: %w", err)
+					return p
+				}
 			}
 
 			// Use the specialized function for rate limit service resolution
@@ -222,6 +261,21 @@ func TranslateGatewayExtensionBuilder(
 				return p
 			}
 			p.OAuth2 = out
+
+		case gExt.Wasm != nil:
+			pluginConfig, err := buildWasmPluginConfig(krtctx, commoncol, gExt)
+			if err != nil {
+				p.Err = fmt.Errorf("wasm: %w", err)
+				return p
+			}
+			p.Wasm = pluginConfig
+			if image := gExt.Wasm.Module.Image; image != nil {
+				p.WasmImage = &ir.WasmImage{
+					GatewayExtension: gExt.ObjectSource,
+					Reference:        image.Reference,
+					PullPolicy:       ptr.Deref(image.PullPolicy, ""),
+				}
+			}
 		}
 		return p
 	}
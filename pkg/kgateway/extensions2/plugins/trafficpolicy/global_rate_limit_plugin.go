@@ -11,6 +11,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/ratelimit"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
@@ -84,11 +85,6 @@ func constructGlobalRateLimit(
 	}
 
 	globalPolicy := in.Spec.RateLimit.Global
-	// Create rate limit actions for the route or vhost
-	actions, err := createRateLimitActions(globalPolicy.Descriptors)
-	if err != nil {
-		return fmt.Errorf("failed to create rate limit actions: %w", err)
-	}
 	gwExtIR, err := fetchGatewayExtension(krtctx, globalPolicy.ExtensionRef, in.GetNamespace())
 	if err != nil {
 		return fmt.Errorf("ratelimit: %w", err)
@@ -96,6 +92,23 @@ func constructGlobalRateLimit(
 	if gwExtIR.RateLimit == nil {
 		return pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeRateLimit)
 	}
+	if gwExtIR.BuiltinRateLimit {
+		rateLimits, err := createBuiltinRateLimits(in.GetNamespace(), in.GetName(), globalPolicy.Descriptors)
+		if err != nil {
+			return fmt.Errorf("failed to create rate limit actions: %w", err)
+		}
+		out.globalRateLimit = &globalRateLimitIR{
+			provider:         gwExtIR,
+			rateLimitActions: rateLimits,
+		}
+		return nil
+	}
+
+	// Create rate limit actions for the route or vhost
+	actions, err := createRateLimitActions(globalPolicy.Descriptors)
+	if err != nil {
+		return fmt.Errorf("failed to create rate limit actions: %w", err)
+	}
 	// Create route rate limits and store in the RateLimitIR struct
 	out.globalRateLimit = &globalRateLimitIR{
 		provider: gwExtIR,
@@ -180,6 +193,38 @@ func createRateLimitActions(descriptors []kgateway.RateLimitDescriptor) ([]*envo
 	return result, nil
 }
 
+// createBuiltinRateLimits translates the API descriptors to Envoy route config rate limits for the built-in
+// rate limit service. Each descriptor becomes a separate rate limit whose first entry identifies the descriptor,
+// so that the service can look up its limit.
+func createBuiltinRateLimits(namespace, name string, descriptors []kgateway.RateLimitDescriptor) ([]*envoyroutev3.RateLimit, error) {
+	if len(descriptors) == 0 {
+		return nil, errors.New("at least one descriptor is required for global rate limiting")
+	}
+
+	result := make([]*envoyroutev3.RateLimit, 0, len(descriptors))
+	for i, descriptor := range descriptors {
+		if descriptor.Limit == nil {
+			return nil, fmt.Errorf("descriptor %d requires a limit when using the built-in rate limit service", i)
+		}
+		actions, err := createRateLimitActions(descriptors[i : i+1])
+		if err != nil {
+			return nil, err
+		}
+		idAction := &envoyroutev3.RateLimit_Action{
+			ActionSpecifier: &envoyroutev3.RateLimit_Action_GenericKey_{
+				GenericKey: &envoyroutev3.RateLimit_Action_GenericKey{
+					DescriptorKey:   ratelimit.DescriptorIDKey,
+					DescriptorValue: ratelimit.DescriptorID(namespace, name, i),
+				},
+			},
+		}
+		result = append(result, &envoyroutev3.RateLimit{
+			Actions: append([]*envoyroutev3.RateLimit_Action{idAction}, actions...),
+		})
+	}
+	return result, nil
+}
+
 func getRateLimitFilterName(name string) string {
 	if name == "" {
 		return rateLimitFilterNamePrefix
@@ -12,6 +12,7 @@ import (
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -589,3 +590,48 @@ func TestToRateLimitFilterConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBuiltinRateLimits(t *testing.T) {
+	limit := &kgateway.RateLimitDescriptorLimit{Requests: 10, Unit: kgateway.RateLimitUnitMinute}
+	remoteAddress := kgateway.RateLimitDescriptorEntry{Type: kgateway.RateLimitDescriptorEntryTypeRemoteAddress}
+	idAction := func(index int) *envoyroutev3.RateLimit_Action {
+		return &envoyroutev3.RateLimit_Action{
+			ActionSpecifier: &envoyroutev3.RateLimit_Action_GenericKey_{
+				GenericKey: &envoyroutev3.RateLimit_Action_GenericKey{
+					DescriptorKey:   "kgateway.dev/descriptor",
+					DescriptorValue: fmt.Sprintf("ns/policy/%d", index),
+				},
+			},
+		}
+	}
+
+	t.Run("one rate limit per descriptor", func(t *testing.T) {
+		rateLimits, err := createBuiltinRateLimits("ns", "policy", []kgateway.RateLimitDescriptor{
+			{
+				Entries: []kgateway.RateLimitDescriptorEntry{remoteAddress},
+				Limit:   limit,
+			},
+			{
+				Entries: []kgateway.RateLimitDescriptorEntry{{Type: kgateway.RateLimitDescriptorEntryTypePath}},
+				Limit:   limit,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, rateLimits, 2)
+		for i, rateLimit := range rateLimits {
+			require.Len(t, rateLimit.GetActions(), 2)
+			assert.True(t, proto.Equal(idAction(i), rateLimit.GetActions()[0]))
+		}
+		assert.NotNil(t, rateLimits[0].GetActions()[1].GetRemoteAddress())
+		assert.Equal(t, "path", rateLimits[1].GetActions()[1].GetRequestHeaders().GetDescriptorKey())
+	})
+
+	t.Run("descriptor without limit", func(t *testing.T) {
+		_, err := createBuiltinRateLimits("ns", "policy", []kgateway.RateLimitDescriptor{
+			{
+				Entries: []kgateway.RateLimitDescriptorEntry{remoteAddress},
+			},
+		})
+		require.ErrorContains(t, err, "descriptor 0 requires a limit")
+	})
+}
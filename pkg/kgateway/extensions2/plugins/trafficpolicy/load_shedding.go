@@ -0,0 +1,318 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"strconv"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoyadaptiveconcurrencyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoyadmissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// loadSheddingFilterStage runs the load shedding filters with the rate limits, so that the requests
+// rejected by the authentication and authorization filters are not forwarded nor measured.
+var loadSheddingFilterStage = filters.DuringStage(filters.RateLimitStage)
+
+// loadSheddingFilterKind describes an Envoy load shedding filter.
+type loadSheddingFilterKind struct {
+	// envoyFilterName is the name of the Envoy filter
+	envoyFilterName string
+	// filterPrefix is the prefix for the filter names
+	filterPrefix string
+	// compositeName is the name of the composite filter wrapping the filter
+	compositeName string
+	// globalDisableFilterName is the name of the filter that disables all the filters of this kind
+	globalDisableFilterName string
+	// globalDisableFilterMetadataNamespace is the metadata namespace for the global disable filter
+	globalDisableFilterMetadataNamespace string
+}
+
+var (
+	adaptiveConcurrencyFilterKind = &loadSheddingFilterKind{
+		envoyFilterName:                      "envoy.filters.http.adaptive_concurrency",
+		filterPrefix:                         "adaptive_concurrency/",
+		compositeName:                        "composite_adaptive_concurrency",
+		globalDisableFilterName:              "global_disable/adaptive_concurrency",
+		globalDisableFilterMetadataNamespace: "dev.kgateway.disable_adaptive_concurrency",
+	}
+	admissionControlFilterKind = &loadSheddingFilterKind{
+		envoyFilterName:                      "envoy.filters.http.admission_control",
+		filterPrefix:                         "admission_control/",
+		compositeName:                        "composite_admission_control",
+		globalDisableFilterName:              "global_disable/admission_control",
+		globalDisableFilterMetadataNamespace: "dev.kgateway.disable_admission_control",
+	}
+)
+
+// loadSheddingIR is the adaptive concurrency or admission control configuration for the policy targets.
+// These Envoy filters do not support per-route configuration, so every unique configuration runs in its
+// own filter that is enabled on the routes using it. Routes sharing a filter share its limits.
+type loadSheddingIR struct {
+	kind *loadSheddingFilterKind
+	// filterName is the unique name of the filter running this configuration
+	filterName string
+	filter     *envoymatchingv3.ExtensionWithMatcher
+	disableAll bool
+}
+
+var _ PolicySubIR = &loadSheddingIR{}
+
+func (l *loadSheddingIR) Equals(other PolicySubIR) bool {
+	otherLoadShedding, ok := other.(*loadSheddingIR)
+	if !ok {
+		return false
+	}
+	if l == nil || otherLoadShedding == nil {
+		return l == nil && otherLoadShedding == nil
+	}
+	return l.kind == otherLoadShedding.kind &&
+		l.filterName == otherLoadShedding.filterName &&
+		l.disableAll == otherLoadShedding.disableAll &&
+		proto.Equal(l.filter, otherLoadShedding.filter)
+}
+
+func (l *loadSheddingIR) Validate() error {
+	if l == nil || l.filter == nil {
+		return nil
+	}
+	return l.filter.ValidateAll()
+}
+
+func newLoadSheddingIR(kind *loadSheddingFilterKind, config proto.Message) *loadSheddingIR {
+	return &loadSheddingIR{
+		kind:       kind,
+		filterName: fmt.Sprintf("%s%016x", kind.filterPrefix, utils.HashProto(config)),
+		filter: buildCompositeFilter(
+			kind.compositeName,
+			kind.globalDisableFilterMetadataNamespace,
+			&envoycorev3.TypedExtensionConfig{
+				Name:        kind.envoyFilterName,
+				TypedConfig: utils.MustMessageToAny(config),
+			},
+		),
+	}
+}
+
+// constructAdaptiveConcurrency constructs the adaptive concurrency policy IR from the policy specification.
+func constructAdaptiveConcurrency(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	in := spec.AdaptiveConcurrency
+	if in == nil {
+		return nil
+	}
+
+	if in.Disable != nil {
+		out.adaptiveConcurrency = &loadSheddingIR{
+			kind:       adaptiveConcurrencyFilterKind,
+			disableAll: true,
+		}
+		return nil
+	}
+	if in.GradientController == nil {
+		return fmt.Errorf("adaptiveConcurrency: gradientController is required")
+	}
+
+	config, err := toAdaptiveConcurrencyConfig(in)
+	if err != nil {
+		return fmt.Errorf("adaptiveConcurrency: %w", err)
+	}
+	out.adaptiveConcurrency = newLoadSheddingIR(adaptiveConcurrencyFilterKind, config)
+	return nil
+}
+
+func toAdaptiveConcurrencyConfig(in *kgateway.AdaptiveConcurrency) (*envoyadaptiveconcurrencyv3.AdaptiveConcurrency, error) {
+	gc := in.GradientController
+	minRTT := gc.MinRTT
+
+	// The concurrency limit is recalculated at the end of every sampling window, so the
+	// minRTT must be measured over a longer window for the gradient to be meaningful
+	if minRTT.Interval != nil && minRTT.Interval.Duration <= gc.ConcurrencyUpdateInterval.Duration {
+		return nil, fmt.Errorf("minRTT.interval (%s) must be longer than concurrencyUpdateInterval (%s)",
+			minRTT.Interval.Duration, gc.ConcurrencyUpdateInterval.Duration)
+	}
+
+	minRTTParams := &envoyadaptiveconcurrencyv3.GradientControllerConfig_MinimumRTTCalculationParams{}
+	if minRTT.Interval != nil {
+		minRTTParams.Interval = durationpb.New(minRTT.Interval.Duration)
+	}
+	if minRTT.FixedValue != nil {
+		minRTTParams.FixedValue = durationpb.New(minRTT.FixedValue.Duration)
+	}
+	if minRTT.RequestCount != nil {
+		minRTTParams.RequestCount = wrapperspb.UInt32(uint32(*minRTT.RequestCount)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if minRTT.Jitter != nil {
+		minRTTParams.Jitter = &envoytypev3.Percent{Value: float64(*minRTT.Jitter)}
+	}
+	if minRTT.MinConcurrency != nil {
+		minRTTParams.MinConcurrency = wrapperspb.UInt32(uint32(*minRTT.MinConcurrency)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if minRTT.Buffer != nil {
+		minRTTParams.Buffer = &envoytypev3.Percent{Value: float64(*minRTT.Buffer)}
+	}
+
+	gradientController := &envoyadaptiveconcurrencyv3.GradientControllerConfig{
+		ConcurrencyLimitParams: &envoyadaptiveconcurrencyv3.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+			ConcurrencyUpdateInterval: durationpb.New(gc.ConcurrencyUpdateInterval.Duration),
+		},
+		MinRttCalcParams: minRTTParams,
+	}
+	if gc.SampleAggregatePercentile != nil {
+		gradientController.SampleAggregatePercentile = &envoytypev3.Percent{Value: float64(*gc.SampleAggregatePercentile)}
+	}
+	if gc.MaxConcurrencyLimit != nil {
+		gradientController.ConcurrencyLimitParams.MaxConcurrencyLimit = wrapperspb.UInt32(uint32(*gc.MaxConcurrencyLimit)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+
+	config := &envoyadaptiveconcurrencyv3.AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &envoyadaptiveconcurrencyv3.AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: gradientController,
+		},
+	}
+	if in.ConcurrencyLimitExceededStatus != nil {
+		config.ConcurrencyLimitExceededStatus = &envoytypev3.HttpStatus{
+			Code: envoytypev3.StatusCode(*in.ConcurrencyLimitExceededStatus),
+		}
+	}
+	return config, nil
+}
+
+// constructAdmissionControl constructs the admission control policy IR from the policy specification.
+func constructAdmissionControl(in *kgateway.TrafficPolicy, out *trafficPolicySpecIr) error {
+	spec := in.Spec.AdmissionControl
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.admissionControl = &loadSheddingIR{
+			kind:       admissionControlFilterKind,
+			disableAll: true,
+		}
+		return nil
+	}
+	if spec.SuccessCriteria == nil {
+		return fmt.Errorf("admissionControl: successCriteria is required")
+	}
+
+	config, err := toAdmissionControlConfig(spec, in.GetName(), in.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("admissionControl: %w", err)
+	}
+	out.admissionControl = newLoadSheddingIR(admissionControlFilterKind, config)
+	return nil
+}
+
+func toAdmissionControlConfig(
+	in *kgateway.AdmissionControl,
+	name, namespace string,
+) (*envoyadmissioncontrolv3.AdmissionControl, error) {
+	successCriteria := &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria{}
+	if len(in.SuccessCriteria.HTTP) > 0 {
+		httpCriteria := &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_HttpCriteria{}
+		for _, statusRange := range in.SuccessCriteria.HTTP {
+			if statusRange.Start >= statusRange.End {
+				return nil, fmt.Errorf("invalid HTTP status range [%d, %d)", statusRange.Start, statusRange.End)
+			}
+			httpCriteria.HttpSuccessStatus = append(httpCriteria.HttpSuccessStatus, &envoytypev3.Int32Range{
+				Start: statusRange.Start,
+				End:   statusRange.End,
+			})
+		}
+		successCriteria.HttpCriteria = httpCriteria
+	}
+	if len(in.SuccessCriteria.GRPC) > 0 {
+		grpcCriteria := &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_GrpcCriteria{}
+		for _, code := range in.SuccessCriteria.GRPC {
+			grpcCriteria.GrpcSuccessStatus = append(grpcCriteria.GrpcSuccessStatus, uint32(code)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+		successCriteria.GrpcCriteria = grpcCriteria
+	}
+
+	config := &envoyadmissioncontrolv3.AdmissionControl{
+		EvaluationCriteria: &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_{
+			SuccessCriteria: successCriteria,
+		},
+	}
+	if in.SamplingWindow != nil {
+		config.SamplingWindow = durationpb.New(in.SamplingWindow.Duration)
+	}
+
+	// Envoy requires runtime keys for the runtime values, so use policy-specific runtime keys.
+	runtimeKeyPrefix := fmt.Sprintf("%s.%s.admissionControl", name, namespace)
+	if in.Aggression != nil {
+		aggression, err := strconv.ParseFloat(*in.Aggression, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aggression %q: %w", *in.Aggression, err)
+		}
+		config.Aggression = &envoycorev3.RuntimeDouble{
+			DefaultValue: aggression,
+			RuntimeKey:   runtimeKeyPrefix + ".aggression",
+		}
+	}
+	if in.SuccessRateThreshold != nil {
+		config.SrThreshold = &envoycorev3.RuntimePercent{
+			DefaultValue: &envoytypev3.Percent{Value: float64(*in.SuccessRateThreshold)},
+			RuntimeKey:   runtimeKeyPrefix + ".successRateThreshold",
+		}
+	}
+	if in.RPSThreshold != nil {
+		config.RpsThreshold = &envoycorev3.RuntimeUInt32{
+			DefaultValue: uint32(*in.RPSThreshold), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			RuntimeKey:   runtimeKeyPrefix + ".rpsThreshold",
+		}
+	}
+	if in.MaxRejectionProbability != nil {
+		config.MaxRejectionProbability = &envoycorev3.RuntimePercent{
+			DefaultValue: &envoytypev3.Percent{Value: float64(*in.MaxRejectionProbability)},
+			RuntimeKey:   runtimeKeyPrefix + ".maxRejectionProbability",
+		}
+	}
+	return config, nil
+}
+
+func (p *trafficPolicyPluginGwPass) handleLoadShedding(filterChain string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, in *loadSheddingIR) {
+	if in == nil {
+		return
+	}
+
+	// Enable the global disable filter to skip all the filters of this kind
+	if in.disableAll {
+		pCtxTypedFilterConfig.AddTypedConfig(in.kind.globalDisableFilterName, EnableFilterPerRoute())
+		return
+	}
+
+	if p.loadSheddingInChain == nil {
+		p.loadSheddingInChain = make(map[string]map[string]*loadSheddingIR)
+	}
+	if p.loadSheddingInChain[filterChain] == nil {
+		p.loadSheddingInChain[filterChain] = make(map[string]*loadSheddingIR)
+	}
+	if _, ok := p.loadSheddingInChain[filterChain][in.filterName]; !ok {
+		p.loadSheddingInChain[filterChain][in.filterName] = in
+	}
+	pCtxTypedFilterConfig.AddTypedConfig(in.filterName, EnableFilterPerRoute())
+}
+
+// loadSheddingFilters returns the disabled load shedding filters of the filter chain, which are enabled
+// on the routes using them, and the global disable filters of their kinds.
+func (p *trafficPolicyPluginGwPass) loadSheddingFilters(filterChain string, stagedFilters []filters.StagedHttpFilter) []filters.StagedHttpFilter {
+	for _, in := range p.loadSheddingInChain[filterChain] {
+		// register the filter that sets metadata so that it can have overrides on the route level
+		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, in.kind.globalDisableFilterName, in.kind.globalDisableFilterMetadataNamespace)
+
+		stagedFilter := filters.MustNewStagedFilter(in.filterName, in.filter, loadSheddingFilterStage)
+		stagedFilter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+	return stagedFilters
+}
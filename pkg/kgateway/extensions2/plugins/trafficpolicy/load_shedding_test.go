@@ -0,0 +1,197 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyadaptiveconcurrencyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoyadmissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructAdaptiveConcurrency(t *testing.T) {
+	gradientController := func(interval time.Duration) *kgateway.GradientController {
+		return &kgateway.GradientController{
+			SampleAggregatePercentile: ptr.To(int32(90)),
+			MaxConcurrencyLimit:       ptr.To(int32(500)),
+			ConcurrencyUpdateInterval: metav1.Duration{Duration: 100 * time.Millisecond},
+			MinRTT: kgateway.MinRTTCalculation{
+				Interval:       &metav1.Duration{Duration: interval},
+				RequestCount:   ptr.To(int32(20)),
+				Jitter:         ptr.To(int32(10)),
+				MinConcurrency: ptr.To(int32(5)),
+				Buffer:         ptr.To(int32(20)),
+			},
+		}
+	}
+
+	t.Run("gradient controller", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAdaptiveConcurrency(kgateway.TrafficPolicySpec{
+			AdaptiveConcurrency: &kgateway.AdaptiveConcurrency{
+				GradientController:             gradientController(time.Minute),
+				ConcurrencyLimitExceededStatus: ptr.To(int32(429)),
+			},
+		}, out)
+		require.NoError(t, err)
+		require.NotNil(t, out.adaptiveConcurrency)
+		assert.Same(t, adaptiveConcurrencyFilterKind, out.adaptiveConcurrency.kind)
+		assert.Contains(t, out.adaptiveConcurrency.filterName, adaptiveConcurrencyFilterKind.filterPrefix)
+		require.NoError(t, out.adaptiveConcurrency.Validate())
+
+		config, err := toAdaptiveConcurrencyConfig(&kgateway.AdaptiveConcurrency{
+			GradientController:             gradientController(time.Minute),
+			ConcurrencyLimitExceededStatus: ptr.To(int32(429)),
+		})
+		require.NoError(t, err)
+		expected := &envoyadaptiveconcurrencyv3.AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &envoyadaptiveconcurrencyv3.AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: &envoyadaptiveconcurrencyv3.GradientControllerConfig{
+					SampleAggregatePercentile: &envoytypev3.Percent{Value: 90},
+					ConcurrencyLimitParams: &envoyadaptiveconcurrencyv3.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+						MaxConcurrencyLimit:       wrapperspb.UInt32(500),
+						ConcurrencyUpdateInterval: durationpb.New(100 * time.Millisecond),
+					},
+					MinRttCalcParams: &envoyadaptiveconcurrencyv3.GradientControllerConfig_MinimumRTTCalculationParams{
+						Interval:       durationpb.New(time.Minute),
+						RequestCount:   wrapperspb.UInt32(20),
+						Jitter:         &envoytypev3.Percent{Value: 10},
+						MinConcurrency: wrapperspb.UInt32(5),
+						Buffer:         &envoytypev3.Percent{Value: 20},
+					},
+				},
+			},
+			ConcurrencyLimitExceededStatus: &envoytypev3.HttpStatus{Code: envoytypev3.StatusCode_TooManyRequests},
+		}
+		assert.True(t, proto.Equal(expected, config), "got %v", config)
+	})
+
+	t.Run("minRTT interval must be longer than the update interval", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAdaptiveConcurrency(kgateway.TrafficPolicySpec{
+			AdaptiveConcurrency: &kgateway.AdaptiveConcurrency{
+				GradientController: gradientController(50 * time.Millisecond),
+			},
+		}, out)
+		require.ErrorContains(t, err, "minRTT.interval (50ms) must be longer than concurrencyUpdateInterval (100ms)")
+		assert.Nil(t, out.adaptiveConcurrency)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAdaptiveConcurrency(kgateway.TrafficPolicySpec{
+			AdaptiveConcurrency: &kgateway.AdaptiveConcurrency{Disable: &shared.PolicyDisable{}},
+		}, out)
+		require.NoError(t, err)
+		require.NotNil(t, out.adaptiveConcurrency)
+		assert.True(t, out.adaptiveConcurrency.disableAll)
+		assert.Nil(t, out.adaptiveConcurrency.filter)
+	})
+}
+
+func TestConstructAdmissionControl(t *testing.T) {
+	policy := func(admissionControl *kgateway.AdmissionControl) *kgateway.TrafficPolicy {
+		return &kgateway.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+			Spec:       kgateway.TrafficPolicySpec{AdmissionControl: admissionControl},
+		}
+	}
+
+	t.Run("runtime values use policy-specific runtime keys", func(t *testing.T) {
+		config, err := toAdmissionControlConfig(&kgateway.AdmissionControl{
+			SuccessCriteria: &kgateway.AdmissionControlSuccessCriteria{
+				HTTP: []kgateway.HTTPStatusRange{{Start: 200, End: 500}},
+				GRPC: []int32{0, 5},
+			},
+			SamplingWindow:          &metav1.Duration{Duration: 10 * time.Second},
+			Aggression:              ptr.To("1.5"),
+			SuccessRateThreshold:    ptr.To(int32(90)),
+			RPSThreshold:            ptr.To(int32(5)),
+			MaxRejectionProbability: ptr.To(int32(70)),
+		}, "policy", "default")
+		require.NoError(t, err)
+
+		expected := &envoyadmissioncontrolv3.AdmissionControl{
+			EvaluationCriteria: &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_{
+				SuccessCriteria: &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria{
+					HttpCriteria: &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_HttpCriteria{
+						HttpSuccessStatus: []*envoytypev3.Int32Range{{Start: 200, End: 500}},
+					},
+					GrpcCriteria: &envoyadmissioncontrolv3.AdmissionControl_SuccessCriteria_GrpcCriteria{
+						GrpcSuccessStatus: []uint32{0, 5},
+					},
+				},
+			},
+			SamplingWindow: durationpb.New(10 * time.Second),
+			Aggression: &envoycorev3.RuntimeDouble{
+				DefaultValue: 1.5,
+				RuntimeKey:   "policy.default.admissionControl.aggression",
+			},
+			SrThreshold: &envoycorev3.RuntimePercent{
+				DefaultValue: &envoytypev3.Percent{Value: 90},
+				RuntimeKey:   "policy.default.admissionControl.successRateThreshold",
+			},
+			RpsThreshold: &envoycorev3.RuntimeUInt32{
+				DefaultValue: 5,
+				RuntimeKey:   "policy.default.admissionControl.rpsThreshold",
+			},
+			MaxRejectionProbability: &envoycorev3.RuntimePercent{
+				DefaultValue: &envoytypev3.Percent{Value: 70},
+				RuntimeKey:   "policy.default.admissionControl.maxRejectionProbability",
+			},
+		}
+		assert.True(t, proto.Equal(expected, config), "got %v", config)
+	})
+
+	t.Run("invalid status range", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAdmissionControl(policy(&kgateway.AdmissionControl{
+			SuccessCriteria: &kgateway.AdmissionControlSuccessCriteria{
+				HTTP: []kgateway.HTTPStatusRange{{Start: 500, End: 200}},
+			},
+		}), out)
+		require.ErrorContains(t, err, "invalid HTTP status range [500, 200)")
+		assert.Nil(t, out.admissionControl)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAdmissionControl(policy(&kgateway.AdmissionControl{Disable: &shared.PolicyDisable{}}), out)
+		require.NoError(t, err)
+		require.NotNil(t, out.admissionControl)
+		assert.True(t, out.admissionControl.disableAll)
+	})
+}
+
+func TestHandleLoadShedding(t *testing.T) {
+	t.Run("filter is enabled on the route and added to the chain", func(t *testing.T) {
+		in := &loadSheddingIR{kind: admissionControlFilterKind, filterName: "admission_control/0000000000000001"}
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleLoadShedding("fc", &typedFilterConfig, in)
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[in.filterName]))
+		assert.Same(t, in, pass.loadSheddingInChain["fc"][in.filterName])
+	})
+
+	t.Run("disable enables the global disable filter", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleLoadShedding("fc", &typedFilterConfig, &loadSheddingIR{kind: adaptiveConcurrencyFilterKind, disableAll: true})
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[adaptiveConcurrencyFilterKind.globalDisableFilterName]))
+		assert.Empty(t, pass.loadSheddingInChain["fc"])
+	})
+}
@@ -1,12 +1,21 @@
 package trafficpolicy
 
 import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -16,10 +25,23 @@ const (
 	localRatelimitFilterEnabledRuntimeKey  = "local_rate_limit_enabled"
 	localRatelimitFilterEnforcedRuntimeKey = "local_rate_limit_enforced"
 	localRatelimitFilterDisabledRuntimeKey = "local_rate_limit_disabled"
+
+	// Descriptor keys of the local rate limit descriptor entries whose key is not user defined
+	localRateLimitRemoteAddressDescriptorKey = "remote_address"
+	localRateLimitPathDescriptorKey          = "path"
+	localRateLimitClientIDDescriptorKey      = "client_id"
+	localRateLimitJWTClaimDescriptorKey      = "jwt_claim"
+
+	// jwtAuthnMetadataNamespace is the dynamic metadata namespace the JWT authentication filter
+	// writes the JWT payload to
+	jwtAuthnMetadataNamespace = "envoy.filters.http.jwt_authn"
 )
 
 type localRateLimitIR struct {
 	config *localratelimitv3.LocalRateLimit
+	// shareKey is set when the token buckets are shared by the routes of a listener using the same
+	// key, in which case the config is set on a filter dedicated to the key instead of on the route
+	shareKey string
 }
 
 var _ PolicySubIR = &localRateLimitIR{}
@@ -35,7 +57,7 @@ func (l *localRateLimitIR) Equals(other PolicySubIR) bool {
 	if l == nil || otherLocalRateLimit == nil {
 		return false
 	}
-	return proto.Equal(l.config, otherLocalRateLimit.config)
+	return l.shareKey == otherLocalRateLimit.shareKey && proto.Equal(l.config, otherLocalRateLimit.config)
 }
 
 func (l *localRateLimitIR) Validate() error {
@@ -46,33 +68,45 @@ func (l *localRateLimitIR) Validate() error {
 }
 
 // constructLocalRateLimit constructs the local rate limit policy IR from the policy specification.
-func constructLocalRateLimit(in *kgateway.TrafficPolicy, out *trafficPolicySpecIr) {
+func constructLocalRateLimit(in *kgateway.TrafficPolicy, out *trafficPolicySpecIr) error {
 	if in.Spec.RateLimit == nil || in.Spec.RateLimit.Local == nil {
-		return
+		return nil
+	}
+	localRateLimit, err := toLocalRateLimitFilterConfig(in.Spec.RateLimit.Local)
+	if err != nil {
+		return err
 	}
-	localRateLimit := toLocalRateLimitFilterConfig(in.Spec.RateLimit.Local)
 	out.localRateLimit = &localRateLimitIR{
 		config: localRateLimit,
 	}
+	// An empty policy disables the rate limits of the route, so there are no token buckets to share
+	if local := in.Spec.RateLimit.Local; local.ShareKey != nil && (local.TokenBucket != nil || len(local.Descriptors) > 0) {
+		out.localRateLimit.shareKey = *local.ShareKey
+	}
+	return nil
 }
 
-func toLocalRateLimitFilterConfig(t *kgateway.LocalRateLimitPolicy) *localratelimitv3.LocalRateLimit {
+func toLocalRateLimitFilterConfig(t *kgateway.LocalRateLimitPolicy) (*localratelimitv3.LocalRateLimit, error) {
 	if t == nil {
-		return nil
+		return nil, nil
 	}
 
 	// If the local rate limit policy is empty, we add a LocalRateLimit configuration that disables
 	// any other applied local rate limit policy (if any) for the target.
-	if *t == (kgateway.LocalRateLimitPolicy{}) {
-		return createDisabledRateLimit()
+	if t.TokenBucket == nil && len(t.Descriptors) == 0 {
+		return createDisabledRateLimit(), nil
 	}
 
-	tokenBucket := &typev3.TokenBucket{}
+	var tokenBucket *typev3.TokenBucket
 	if t.TokenBucket != nil {
-		tokenBucket.FillInterval = durationpb.New(t.TokenBucket.FillInterval.Duration)
-		tokenBucket.MaxTokens = uint32(t.TokenBucket.MaxTokens) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
-		if t.TokenBucket.TokensPerFill != nil {
-			tokenBucket.TokensPerFill = wrapperspb.UInt32(uint32(*t.TokenBucket.TokensPerFill)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		tokenBucket = toTokenBucket(*t.TokenBucket)
+	} else {
+		// Config per route requires a token bucket, so we create one that never runs out of tokens
+		// to only limit the requests matching the descriptors
+		tokenBucket = &typev3.TokenBucket{
+			MaxTokens:     math.MaxUint32,
+			TokensPerFill: wrapperspb.UInt32(math.MaxUint32),
+			FillInterval:  durationpb.New(50 * time.Millisecond),
 		}
 	}
 
@@ -100,7 +134,118 @@ func toLocalRateLimitFilterConfig(t *kgateway.LocalRateLimitPolicy) *localrateli
 		},
 	}
 
-	return lrl
+	for i, descriptor := range t.Descriptors {
+		rateLimit, localDescriptor, err := toLocalRateLimitDescriptor(descriptor)
+		if err != nil {
+			return nil, fmt.Errorf("local rate limit descriptor %d: %w", i, err)
+		}
+		// Descriptors that differ only by their values are generated by the same actions
+		if !slices.ContainsFunc(lrl.RateLimits, func(r *envoyroutev3.RateLimit) bool { return proto.Equal(r, rateLimit) }) {
+			lrl.RateLimits = append(lrl.RateLimits, rateLimit)
+		}
+		lrl.Descriptors = append(lrl.Descriptors, localDescriptor)
+	}
+	if t.MaxDynamicDescriptors != nil {
+		lrl.MaxDynamicDescriptors = wrapperspb.UInt32(uint32(*t.MaxDynamicDescriptors)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if t.XRateLimitHeaders != nil && *t.XRateLimitHeaders == kgateway.XRateLimitHeaderDraftV03 {
+		lrl.EnableXRatelimitHeaders = ratelimitv3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03
+	}
+
+	return lrl, nil
+}
+
+func toTokenBucket(t kgateway.TokenBucket) *typev3.TokenBucket {
+	tokenBucket := &typev3.TokenBucket{
+		FillInterval: durationpb.New(t.FillInterval.Duration),
+		MaxTokens:    uint32(t.MaxTokens), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if t.TokensPerFill != nil {
+		tokenBucket.TokensPerFill = wrapperspb.UInt32(uint32(*t.TokensPerFill)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	return tokenBucket
+}
+
+// toLocalRateLimitDescriptor translates an API descriptor to the rate limit actions generating the
+// descriptor from the request, and to the local rate limit descriptor holding its token bucket.
+// Entries without a value are translated to wildcard entries, for which Envoy creates a token bucket per distinct value.
+func toLocalRateLimitDescriptor(
+	descriptor kgateway.LocalRateLimitDescriptor,
+) (*envoyroutev3.RateLimit, *ratelimitv3.LocalRateLimitDescriptor, error) {
+	rateLimit := &envoyroutev3.RateLimit{}
+	localDescriptor := &ratelimitv3.LocalRateLimitDescriptor{
+		TokenBucket: toTokenBucket(descriptor.TokenBucket),
+	}
+	for _, entry := range descriptor.Entries {
+		action := &envoyroutev3.RateLimit_Action{}
+		var key string
+		switch entry.Type {
+		case kgateway.LocalRateLimitDescriptorEntryTypeHeader:
+			if entry.Header == nil {
+				return nil, nil, fmt.Errorf("header entry requires Header field to be set")
+			}
+			key = *entry.Header
+			action.ActionSpecifier = &envoyroutev3.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoyroutev3.RateLimit_Action_RequestHeaders{
+					HeaderName:    *entry.Header,
+					DescriptorKey: key,
+				},
+			}
+		case kgateway.LocalRateLimitDescriptorEntryTypeRemoteAddress:
+			// The remote address action always uses the remote_address descriptor key
+			key = localRateLimitRemoteAddressDescriptorKey
+			action.ActionSpecifier = &envoyroutev3.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &envoyroutev3.RateLimit_Action_RemoteAddress{},
+			}
+		case kgateway.LocalRateLimitDescriptorEntryTypePath:
+			key = localRateLimitPathDescriptorKey
+			action.ActionSpecifier = &envoyroutev3.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoyroutev3.RateLimit_Action_RequestHeaders{
+					HeaderName:    ":path",
+					DescriptorKey: key,
+				},
+			}
+		case kgateway.LocalRateLimitDescriptorEntryTypeJWTClaim:
+			if entry.JWTClaim == nil {
+				return nil, nil, fmt.Errorf("JWT claim entry requires JWTClaim field to be set")
+			}
+			key = localRateLimitJWTClaimDescriptorKey + "." + *entry.JWTClaim
+			action.ActionSpecifier = &envoyroutev3.RateLimit_Action_Metadata{
+				Metadata: &envoyroutev3.RateLimit_Action_MetaData{
+					DescriptorKey: key,
+					MetadataKey: &metadatav3.MetadataKey{
+						Key: jwtAuthnMetadataNamespace,
+						Path: []*metadatav3.MetadataKey_PathSegment{
+							{Segment: &metadatav3.MetadataKey_PathSegment_Key{Key: PayloadInMetadata}},
+							{Segment: &metadatav3.MetadataKey_PathSegment_Key{Key: *entry.JWTClaim}},
+						},
+					},
+					Source: envoyroutev3.RateLimit_Action_MetaData_DYNAMIC,
+					// Requests without the claim do not match the descriptor
+					SkipIfAbsent: true,
+				},
+			}
+		case kgateway.LocalRateLimitDescriptorEntryTypeAPIKeyClientID:
+			if entry.Header == nil {
+				return nil, nil, fmt.Errorf("API key client ID entry requires Header field to be set")
+			}
+			key = localRateLimitClientIDDescriptorKey
+			action.ActionSpecifier = &envoyroutev3.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoyroutev3.RateLimit_Action_RequestHeaders{
+					HeaderName:    *entry.Header,
+					DescriptorKey: key,
+				},
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported entry type: %s", entry.Type)
+		}
+		rateLimit.Actions = append(rateLimit.Actions, action)
+		localDescriptor.Entries = append(localDescriptor.Entries, &ratelimitv3.RateLimitDescriptor_Entry{
+			Key:   key,
+			Value: ptr.Deref(entry.Value, ""),
+		})
+	}
+	return rateLimit, localDescriptor, nil
 }
 
 // createDisabledRateLimit returns a LocalRateLimit configuration that disables rate limiting.
@@ -125,7 +270,25 @@ func (p *trafficPolicyPluginGwPass) handleLocalRateLimit(fcn string, typedFilter
 	if localRateLimit == nil {
 		return
 	}
-	typedFilterConfig.AddTypedConfig(localRateLimitFilterNamePrefix, localRateLimit.config)
+	if localRateLimit.shareKey != "" {
+		// The token buckets of the filter config are shared by all the routes enabling the filter.
+		// The route level config is disabled so that it does not also apply the rate limits of
+		// a policy targeting the Gateway or the listener.
+		filterName := localRateLimitSharedFilterName(localRateLimit.shareKey)
+		if p.sharedRateLimitInChain == nil {
+			p.sharedRateLimitInChain = make(map[string]map[string]*localratelimitv3.LocalRateLimit)
+		}
+		if p.sharedRateLimitInChain[fcn] == nil {
+			p.sharedRateLimitInChain[fcn] = make(map[string]*localratelimitv3.LocalRateLimit)
+		}
+		if _, ok := p.sharedRateLimitInChain[fcn][filterName]; !ok {
+			p.sharedRateLimitInChain[fcn][filterName] = localRateLimit.config
+		}
+		typedFilterConfig.AddTypedConfig(filterName, EnableFilterPerRoute())
+		typedFilterConfig.AddTypedConfig(localRateLimitFilterNamePrefix, createDisabledRateLimit())
+	} else {
+		typedFilterConfig.AddTypedConfig(localRateLimitFilterNamePrefix, localRateLimit.config)
+	}
 
 	// Add a filter to the chain. When having a rate limit for a route we need to also have a
 	// globally disabled rate limit filter in the chain otherwise it will be ignored.
@@ -139,3 +302,9 @@ func (p *trafficPolicyPluginGwPass) handleLocalRateLimit(fcn string, typedFilter
 		}
 	}
 }
+
+// localRateLimitSharedFilterName returns the name of the local rate limit filter holding the token buckets
+// shared by the routes using the share key.
+func localRateLimitSharedFilterName(shareKey string) string {
+	return localRateLimitFilterNamePrefix + "/" + shareKey
+}
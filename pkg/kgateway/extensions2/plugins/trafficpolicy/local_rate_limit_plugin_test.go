@@ -4,11 +4,19 @@ import (
 	"testing"
 	"time"
 
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 func TestLocalRateLimitIREquals(t *testing.T) {
@@ -191,3 +199,177 @@ func TestLocalRateLimitIRValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestToLocalRateLimitFilterConfigDescriptors(t *testing.T) {
+	perClient := kgateway.TokenBucket{
+		MaxTokens:    10,
+		FillInterval: metav1.Duration{Duration: time.Second},
+	}
+
+	t.Run("entries are translated to rate limit actions and descriptors", func(t *testing.T) {
+		lrl, err := toLocalRateLimitFilterConfig(&kgateway.LocalRateLimitPolicy{
+			Descriptors: []kgateway.LocalRateLimitDescriptor{
+				{
+					Entries: []kgateway.LocalRateLimitDescriptorEntry{
+						{Type: kgateway.LocalRateLimitDescriptorEntryTypeHeader, Header: ptr.To("x-tenant"), Value: ptr.To("gold")},
+						{Type: kgateway.LocalRateLimitDescriptorEntryTypeRemoteAddress},
+					},
+					TokenBucket: perClient,
+				},
+				{
+					Entries: []kgateway.LocalRateLimitDescriptorEntry{
+						{Type: kgateway.LocalRateLimitDescriptorEntryTypeJWTClaim, JWTClaim: ptr.To("sub")},
+					},
+					TokenBucket: perClient,
+				},
+				{
+					Entries: []kgateway.LocalRateLimitDescriptorEntry{
+						{Type: kgateway.LocalRateLimitDescriptorEntryTypeAPIKeyClientID, Header: ptr.To("x-client-id")},
+					},
+					TokenBucket: perClient,
+				},
+			},
+			MaxDynamicDescriptors: ptr.To(int32(100)),
+			XRateLimitHeaders:     ptr.To(kgateway.XRateLimitHeaderDraftV03),
+		})
+		require.NoError(t, err)
+		require.NoError(t, lrl.ValidateAll())
+
+		// Without a token bucket, the default bucket must not limit any request
+		assert.Equal(t, uint32(4294967295), lrl.GetTokenBucket().GetMaxTokens())
+		assert.Equal(t, uint32(100), lrl.GetMaxDynamicDescriptors().GetValue())
+		assert.Equal(t, ratelimitv3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03, lrl.GetEnableXRatelimitHeaders())
+
+		require.Len(t, lrl.GetRateLimits(), 3)
+		require.Len(t, lrl.GetDescriptors(), 3)
+
+		assert.Equal(t, "x-tenant", lrl.GetRateLimits()[0].GetActions()[0].GetRequestHeaders().GetHeaderName())
+		assert.NotNil(t, lrl.GetRateLimits()[0].GetActions()[1].GetRemoteAddress())
+		assert.True(t, proto.Equal(&ratelimitv3.LocalRateLimitDescriptor{
+			Entries: []*ratelimitv3.RateLimitDescriptor_Entry{
+				{Key: "x-tenant", Value: "gold"},
+				{Key: localRateLimitRemoteAddressDescriptorKey},
+			},
+			TokenBucket: &typev3.TokenBucket{
+				MaxTokens:    10,
+				FillInterval: durationpb.New(time.Second),
+			},
+		}, lrl.GetDescriptors()[0]))
+
+		claim := lrl.GetRateLimits()[1].GetActions()[0].GetMetadata()
+		assert.Equal(t, "jwt_claim.sub", claim.GetDescriptorKey())
+		assert.Equal(t, jwtAuthnMetadataNamespace, claim.GetMetadataKey().GetKey())
+		assert.Equal(t, "sub", claim.GetMetadataKey().GetPath()[1].GetKey())
+		assert.Equal(t, "jwt_claim.sub", lrl.GetDescriptors()[1].GetEntries()[0].GetKey())
+
+		assert.Equal(t, "x-client-id", lrl.GetRateLimits()[2].GetActions()[0].GetRequestHeaders().GetHeaderName())
+		assert.Equal(t, localRateLimitClientIDDescriptorKey, lrl.GetDescriptors()[2].GetEntries()[0].GetKey())
+	})
+
+	t.Run("token bucket is kept with descriptors", func(t *testing.T) {
+		lrl, err := toLocalRateLimitFilterConfig(&kgateway.LocalRateLimitPolicy{
+			TokenBucket: &perClient,
+			Descriptors: []kgateway.LocalRateLimitDescriptor{{
+				Entries:     []kgateway.LocalRateLimitDescriptorEntry{{Type: kgateway.LocalRateLimitDescriptorEntryTypePath}},
+				TokenBucket: perClient,
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(10), lrl.GetTokenBucket().GetMaxTokens())
+		assert.Equal(t, ratelimitv3.XRateLimitHeadersRFCVersion_OFF, lrl.GetEnableXRatelimitHeaders())
+	})
+
+	t.Run("descriptors differing by their values share their actions", func(t *testing.T) {
+		lrl, err := toLocalRateLimitFilterConfig(&kgateway.LocalRateLimitPolicy{
+			Descriptors: []kgateway.LocalRateLimitDescriptor{
+				{
+					Entries:     []kgateway.LocalRateLimitDescriptorEntry{{Type: kgateway.LocalRateLimitDescriptorEntryTypeHeader, Header: ptr.To("x-tenant"), Value: ptr.To("gold")}},
+					TokenBucket: perClient,
+				},
+				{
+					Entries:     []kgateway.LocalRateLimitDescriptorEntry{{Type: kgateway.LocalRateLimitDescriptorEntryTypeHeader, Header: ptr.To("x-tenant")}},
+					TokenBucket: perClient,
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, lrl.GetRateLimits(), 1)
+		assert.Len(t, lrl.GetDescriptors(), 2)
+	})
+
+	t.Run("entry without its field", func(t *testing.T) {
+		_, err := toLocalRateLimitFilterConfig(&kgateway.LocalRateLimitPolicy{
+			Descriptors: []kgateway.LocalRateLimitDescriptor{{
+				Entries:     []kgateway.LocalRateLimitDescriptorEntry{{Type: kgateway.LocalRateLimitDescriptorEntryTypeJWTClaim}},
+				TokenBucket: perClient,
+			}},
+		})
+		assert.ErrorContains(t, err, "local rate limit descriptor 0")
+	})
+
+	t.Run("empty policy disables rate limiting", func(t *testing.T) {
+		lrl, err := toLocalRateLimitFilterConfig(&kgateway.LocalRateLimitPolicy{})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(createDisabledRateLimit(), lrl))
+	})
+}
+
+func TestHandleLocalRateLimitShareKey(t *testing.T) {
+	policy := func(shareKey *string, maxTokens int32) *kgateway.TrafficPolicy {
+		return &kgateway.TrafficPolicy{Spec: kgateway.TrafficPolicySpec{RateLimit: &kgateway.RateLimit{
+			Local: &kgateway.LocalRateLimitPolicy{
+				TokenBucket: &kgateway.TokenBucket{MaxTokens: maxTokens, FillInterval: metav1.Duration{Duration: time.Second}},
+				ShareKey:    shareKey,
+			},
+		}}}
+	}
+	construct := func(in *kgateway.TrafficPolicy) *localRateLimitIR {
+		var out trafficPolicySpecIr
+		require.NoError(t, constructLocalRateLimit(in, &out))
+		return out.localRateLimit
+	}
+
+	t.Run("routes with the same share key enable the same filter", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		first := construct(policy(ptr.To("shared"), 10))
+		routeA, routeB := ir.TypedFilterConfigMap{}, ir.TypedFilterConfigMap{}
+		pass.handleLocalRateLimit("fc", &routeA, first)
+		pass.handleLocalRateLimit("fc", &routeB, construct(policy(ptr.To("shared"), 20)))
+
+		filterName := localRateLimitSharedFilterName("shared")
+		for _, route := range []ir.TypedFilterConfigMap{routeA, routeB} {
+			assert.True(t, proto.Equal(EnableFilterPerRoute(), route[filterName]))
+			assert.True(t, proto.Equal(createDisabledRateLimit(), route[localRateLimitFilterNamePrefix]))
+		}
+		// the token bucket of the first policy is shared by both routes
+		require.Len(t, pass.sharedRateLimitInChain["fc"], 1)
+		assert.Same(t, first.config, pass.sharedRateLimitInChain["fc"][filterName])
+
+		stagedFilters, err := pass.HttpFilters(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "fc"})
+		require.NoError(t, err)
+		var names []string
+		for _, f := range stagedFilters {
+			assert.True(t, f.Filter.GetDisabled())
+			names = append(names, f.Filter.GetName())
+		}
+		assert.Equal(t, []string{localRateLimitFilterNamePrefix, filterName}, names)
+	})
+
+	t.Run("route without share key has its own token bucket", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		route := ir.TypedFilterConfigMap{}
+		lrl := construct(policy(nil, 10))
+		pass.handleLocalRateLimit("fc", &route, lrl)
+
+		assert.Same(t, lrl.config, route[localRateLimitFilterNamePrefix])
+		assert.Empty(t, pass.sharedRateLimitInChain["fc"])
+	})
+
+	t.Run("empty policy with a share key disables rate limiting", func(t *testing.T) {
+		lrl := construct(&kgateway.TrafficPolicy{Spec: kgateway.TrafficPolicySpec{RateLimit: &kgateway.RateLimit{
+			Local: &kgateway.LocalRateLimitPolicy{ShareKey: ptr.To("shared")},
+		}}})
+		assert.Empty(t, lrl.shareKey)
+		assert.True(t, proto.Equal(createDisabledRateLimit(), lrl.config))
+	})
+}
@@ -57,6 +57,16 @@ func MergeTrafficPolicies(
 		mergeURLRewrite,
 		mergeAPIKeyAuth,
 		mergeOAuth,
+		mergeFaultInjection,
+		mergeWasm,
+		mergeCache,
+		mergeBandwidthLimit,
+		mergeErrorPages,
+		mergeAdaptiveConcurrency,
+		mergeAdmissionControl,
+		mergeNetworkAuthorization,
+		mergeUDPSession,
+		mergeRedisProxy,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -485,6 +495,51 @@ func mergeRBAC(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rbac")
 }
 
+func mergeNetworkAuthorization(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[networkAuthorizationIR]{
+		Get: func(spec *trafficPolicySpecIr) *networkAuthorizationIR { return spec.networkAuthorization },
+		Set: func(spec *trafficPolicySpecIr, val *networkAuthorizationIR) { spec.networkAuthorization = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "networkAuthorization")
+}
+
+func mergeUDPSession(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[udpSessionIR]{
+		Get: func(spec *trafficPolicySpecIr) *udpSessionIR { return spec.udpSession },
+		Set: func(spec *trafficPolicySpecIr, val *udpSessionIR) { spec.udpSession = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "udpSession")
+}
+
+func mergeRedisProxy(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[redisProxyIR]{
+		Get: func(spec *trafficPolicySpecIr) *redisProxyIR { return spec.redisProxy },
+		Set: func(spec *trafficPolicySpecIr, val *redisProxyIR) { spec.redisProxy = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "redisProxy")
+}
+
 func mergeAPIKeyAuth(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -545,6 +600,21 @@ func mergeURLRewrite(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "urlRewrite")
 }
 
+func mergeFaultInjection(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[faultInjectionIR]{
+		Get: func(spec *trafficPolicySpecIr) *faultInjectionIR { return spec.faultInjection },
+		Set: func(spec *trafficPolicySpecIr, val *faultInjectionIR) { spec.faultInjection = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "faultInjection")
+}
+
 // fieldAccessor defines how to access and set a field on trafficPolicySpecIr
 type fieldAccessor[T any] struct {
 	Get func(*trafficPolicySpecIr) *T
@@ -584,3 +654,93 @@ func defaultMerge[T any](
 		logger.Warn("unsupported merge strategy for policy", "strategy", opts.Strategy, "policy", p2Ref, "field", fieldName)
 	}
 }
+
+func mergeWasm(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[wasmIR]{
+		Get: func(spec *trafficPolicySpecIr) *wasmIR { return spec.wasm },
+		Set: func(spec *trafficPolicySpecIr, val *wasmIR) { spec.wasm = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "wasm")
+}
+
+func mergeCache(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[cacheIR]{
+		Get: func(spec *trafficPolicySpecIr) *cacheIR { return spec.cache },
+		Set: func(spec *trafficPolicySpecIr, val *cacheIR) { spec.cache = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "cache")
+}
+
+func mergeBandwidthLimit(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[bandwidthLimitIR]{
+		Get: func(spec *trafficPolicySpecIr) *bandwidthLimitIR { return spec.bandwidthLimit },
+		Set: func(spec *trafficPolicySpecIr, val *bandwidthLimitIR) { spec.bandwidthLimit = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "bandwidthLimit")
+}
+
+func mergeErrorPages(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[errorPagesIR]{
+		Get: func(spec *trafficPolicySpecIr) *errorPagesIR { return spec.errorPages },
+		Set: func(spec *trafficPolicySpecIr, val *errorPagesIR) { spec.errorPages = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "errorPages")
+}
+
+func mergeAdaptiveConcurrency(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[loadSheddingIR]{
+		Get: func(spec *trafficPolicySpecIr) *loadSheddingIR { return spec.adaptiveConcurrency },
+		Set: func(spec *trafficPolicySpecIr, val *loadSheddingIR) { spec.adaptiveConcurrency = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "adaptiveConcurrency")
+}
+
+func mergeAdmissionControl(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[loadSheddingIR]{
+		Get: func(spec *trafficPolicySpecIr) *loadSheddingIR { return spec.admissionControl },
+		Set: func(spec *trafficPolicySpecIr, val *loadSheddingIR) { spec.admissionControl = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "admissionControl")
+}
@@ -0,0 +1,212 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoynetworkrbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	sharedv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const networkAuthorizationStatPrefix = "network_authorization"
+
+// networkAuthorizationIR is the internal representation of a connection-level authorization policy.
+type networkAuthorizationIR struct {
+	rbac *envoynetworkrbacv3.RBAC
+}
+
+func (n *networkAuthorizationIR) Equals(other *networkAuthorizationIR) bool {
+	if n == nil && other == nil {
+		return true
+	}
+	if n == nil || other == nil {
+		return false
+	}
+	return proto.Equal(n.rbac, other.rbac)
+}
+
+// Validate performs validation on the network authorization component.
+func (n *networkAuthorizationIR) Validate() error {
+	if n == nil || n.rbac == nil {
+		return nil
+	}
+	return n.rbac.Validate()
+}
+
+// constructNetworkAuthorization translates the network authorization spec into an envoy network RBAC filter
+// and stores it in the traffic policy IR
+func constructNetworkAuthorization(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.NetworkAuthorization == nil {
+		return nil
+	}
+
+	rbac, err := translateNetworkAuthorization(spec.NetworkAuthorization)
+	if err != nil {
+		return err
+	}
+	out.networkAuthorization = &networkAuthorizationIR{
+		rbac: rbac,
+	}
+	return nil
+}
+
+func translateNetworkAuthorization(in *kgateway.NetworkAuthorization) (*envoynetworkrbacv3.RBAC, error) {
+	action := envoyrbacv3.RBAC_ALLOW
+	if in.Action == sharedv1alpha1.AuthorizationPolicyActionDeny {
+		action = envoyrbacv3.RBAC_DENY
+	}
+
+	policies := make(map[string]*envoyrbacv3.Policy, len(in.Rules))
+	for i, rule := range in.Rules {
+		policy, err := translateNetworkAuthorizationRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid networkAuthorization rule %d: %w", i, err)
+		}
+		policies[fmt.Sprintf("rule-%d", i)] = policy
+	}
+
+	return &envoynetworkrbacv3.RBAC{
+		StatPrefix: networkAuthorizationStatPrefix,
+		Rules: &envoyrbacv3.RBAC{
+			Action:   action,
+			Policies: policies,
+		},
+	}, nil
+}
+
+// translateNetworkAuthorizationRule translates a rule into an RBAC policy. The server names are
+// matched by the permissions, and the source addresses and the principals by the principals.
+func translateNetworkAuthorizationRule(rule kgateway.NetworkAuthorizationRule) (*envoyrbacv3.Policy, error) {
+	policy := &envoyrbacv3.Policy{}
+
+	for _, name := range rule.ServerNames {
+		policy.Permissions = append(policy.Permissions, &envoyrbacv3.Permission{
+			Rule: &envoyrbacv3.Permission_RequestedServerName{
+				RequestedServerName: serverNameMatcher(string(name)),
+			},
+		})
+	}
+	if len(policy.Permissions) == 0 {
+		policy.Permissions = []*envoyrbacv3.Permission{{
+			Rule: &envoyrbacv3.Permission_Any{Any: true},
+		}}
+	}
+
+	var sources []*envoyrbacv3.Principal
+	for _, cidr := range rule.SourceCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source CIDR %q: %w", cidr, err)
+		}
+		sources = append(sources, &envoyrbacv3.Principal{
+			Identifier: &envoyrbacv3.Principal_RemoteIp{
+				RemoteIp: &envoycorev3.CidrRange{
+					AddressPrefix: prefix.Masked().Addr().String(),
+					PrefixLen:     wrapperspb.UInt32(uint32(prefix.Bits())), //nolint:gosec // G115: prefix length is at most 128
+				},
+			},
+		})
+	}
+	var principals []*envoyrbacv3.Principal
+	for _, principal := range rule.Principals {
+		principals = append(principals, &envoyrbacv3.Principal{
+			Identifier: &envoyrbacv3.Principal_Authenticated_{
+				Authenticated: &envoyrbacv3.Principal_Authenticated{
+					PrincipalName: &envoymatcherv3.StringMatcher{
+						MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: principal},
+					},
+				},
+			},
+		})
+	}
+
+	switch {
+	case len(sources) > 0 && len(principals) > 0:
+		policy.Principals = []*envoyrbacv3.Principal{{
+			Identifier: &envoyrbacv3.Principal_AndIds{
+				AndIds: &envoyrbacv3.Principal_Set{
+					Ids: []*envoyrbacv3.Principal{orPrincipals(sources), orPrincipals(principals)},
+				},
+			},
+		}}
+	case len(sources) > 0:
+		policy.Principals = sources
+	case len(principals) > 0:
+		policy.Principals = principals
+	default:
+		policy.Principals = []*envoyrbacv3.Principal{{
+			Identifier: &envoyrbacv3.Principal_Any{Any: true},
+		}}
+	}
+
+	return policy, nil
+}
+
+// serverNameMatcher matches the SNI of a connection, case-insensitively. Wildcard names match all subdomains.
+func serverNameMatcher(name string) *envoymatcherv3.StringMatcher {
+	if suffix, ok := strings.CutPrefix(name, "*"); ok {
+		return &envoymatcherv3.StringMatcher{
+			MatchPattern: &envoymatcherv3.StringMatcher_Suffix{Suffix: suffix},
+			IgnoreCase:   true,
+		}
+	}
+	return &envoymatcherv3.StringMatcher{
+		MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: name},
+		IgnoreCase:   true,
+	}
+}
+
+func orPrincipals(ids []*envoyrbacv3.Principal) *envoyrbacv3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoyrbacv3.Principal{
+		Identifier: &envoyrbacv3.Principal_OrIds{
+			OrIds: &envoyrbacv3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+// ApplyForTcpRoute adds the network RBAC filter of policies attached to TCPRoutes and TLSRoutes,
+// and replaces the tcp_proxy filter with a Redis proxy filter for policies with Redis proxy settings.
+func (p *trafficPolicyPluginGwPass) ApplyForTcpRoute(pCtx *ir.TcpRouteContext) ([]filters.StagedNetworkFilter, error) {
+	policy, ok := pCtx.Policy.(*TrafficPolicy)
+	if !ok {
+		return nil, nil
+	}
+	if policy.spec.redisProxy != nil {
+		if err := applyRedisProxy(pCtx, policy.spec.redisProxy); err != nil {
+			return nil, ir.DenyConnectionsError{Err: err}
+		}
+	}
+	if policy.spec.networkAuthorization == nil {
+		return nil, nil
+	}
+
+	rbacAny, err := utils.MessageToAny(policy.spec.networkAuthorization.rbac)
+	if err != nil {
+		return nil, ir.DenyConnectionsError{Err: err}
+	}
+	return []filters.StagedNetworkFilter{{
+		Filter: &envoylistenerv3.Filter{
+			Name: wellknown.RoleBasedAccessControl,
+			ConfigType: &envoylistenerv3.Filter_TypedConfig{
+				TypedConfig: rbacAny,
+			},
+		},
+		Stage: filters.DuringStage(filters.AuthZStage),
+	}}, nil
+}
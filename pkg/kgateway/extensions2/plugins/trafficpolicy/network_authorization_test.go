@@ -0,0 +1,53 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestTranslateNetworkAuthorization(t *testing.T) {
+	out, err := translateNetworkAuthorization(&kgateway.NetworkAuthorization{
+		Action: shared.AuthorizationPolicyActionDeny,
+		Rules: []kgateway.NetworkAuthorizationRule{
+			{
+				ServerNames: []gwv1.Hostname{"db.example.com", "*.internal.example.com"},
+				Principals:  []string{"spiffe://cluster.local/ns/default/sa/a", "spiffe://cluster.local/ns/default/sa/b"},
+			},
+			{
+				SourceCIDRs: []string{"10.1.2.3/16"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	assert.Equal(t, envoyrbacv3.RBAC_DENY, out.GetRules().GetAction())
+
+	rule0 := out.GetRules().GetPolicies()["rule-0"]
+	require.Len(t, rule0.GetPermissions(), 2)
+	exact := rule0.GetPermissions()[0].GetRequestedServerName()
+	assert.Equal(t, "db.example.com", exact.GetExact())
+	assert.True(t, exact.GetIgnoreCase())
+	assert.Equal(t, ".internal.example.com", rule0.GetPermissions()[1].GetRequestedServerName().GetSuffix())
+	require.Len(t, rule0.GetPrincipals(), 2)
+	assert.Equal(t, "spiffe://cluster.local/ns/default/sa/b", rule0.GetPrincipals()[1].GetAuthenticated().GetPrincipalName().GetExact())
+
+	rule1 := out.GetRules().GetPolicies()["rule-1"]
+	assert.True(t, rule1.GetPermissions()[0].GetAny())
+	require.Len(t, rule1.GetPrincipals(), 1)
+	assert.Equal(t, "10.1.0.0", rule1.GetPrincipals()[0].GetRemoteIp().GetAddressPrefix())
+	assert.Equal(t, uint32(16), rule1.GetPrincipals()[0].GetRemoteIp().GetPrefixLen().GetValue())
+
+	_, err = translateNetworkAuthorization(&kgateway.NetworkAuthorization{
+		Rules: []kgateway.NetworkAuthorizationRule{{
+			SourceCIDRs: []string{"10.0.0.1"},
+		}},
+	})
+	assert.ErrorContains(t, err, "invalid source CIDR")
+}
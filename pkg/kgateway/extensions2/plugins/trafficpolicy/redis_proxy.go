@@ -0,0 +1,234 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyredisproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	kgwwellknown "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	defaultRedisOperationTimeout = time.Second
+	redisAuthPasswordKey         = "password"
+	redisAuthUsernameKey         = "username"
+)
+
+var errRedisProxySingleBackend = errors.New("redisProxy requires the TCPRoute to have a single backendRef")
+
+// redisProxyIR is the internal representation of the Redis proxy settings of a TCPRoute.
+type redisProxyIR struct {
+	// proxy is the Redis proxy config without the stat prefix and the catch-all route,
+	// which depend on the filter chain the policy is applied to.
+	proxy *envoyredisproxyv3.RedisProxy
+	// readCluster is the cluster receiving the read commands of the catch-all route, if any.
+	readCluster string
+}
+
+func (r *redisProxyIR) Equals(other *redisProxyIR) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	return r.readCluster == other.readCluster && proto.Equal(r.proxy, other.proxy)
+}
+
+// Validate performs validation on the redis proxy component.
+func (r *redisProxyIR) Validate() error {
+	if r == nil || r.proxy == nil {
+		return nil
+	}
+	// the stat prefix is required, and only set when the policy is applied
+	proxy := proto.Clone(r.proxy).(*envoyredisproxyv3.RedisProxy)
+	proxy.StatPrefix = "redis"
+	return proxy.Validate()
+}
+
+// constructRedisProxy constructs the redis proxy policy IR from the policy specification.
+// The backends of the prefix routes are resolved relative to the policy.
+func constructRedisProxy(
+	krtctx krt.HandlerContext,
+	policyCR *kgateway.TrafficPolicy,
+	commoncol *collections.CommonCollections,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policyCR.Spec.RedisProxy
+	if spec == nil {
+		return nil
+	}
+
+	policySrc := ir.ObjectSource{
+		Group:     kgwwellknown.TrafficPolicyGVK.Group,
+		Kind:      kgwwellknown.TrafficPolicyGVK.Kind,
+		Namespace: policyCR.Namespace,
+		Name:      policyCR.Name,
+	}
+	resolveCluster := func(ref gwv1.BackendObjectReference) (string, error) {
+		backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, policySrc, ref)
+		if err != nil {
+			return "", fmt.Errorf("redisProxy: %w", err)
+		}
+		return backend.ClusterName(), nil
+	}
+
+	opTimeout := defaultRedisOperationTimeout
+	if spec.OperationTimeout != nil {
+		opTimeout = spec.OperationTimeout.Duration
+	}
+	proxy := &envoyredisproxyv3.RedisProxy{
+		Settings: &envoyredisproxyv3.RedisProxy_ConnPoolSettings{
+			OpTimeout:          durationpb.New(opTimeout),
+			EnableCommandStats: spec.CommandStats != nil && *spec.CommandStats,
+			ReadPolicy:         toRedisReadPolicy(spec.ReadPolicy),
+		},
+		PrefixRoutes: &envoyredisproxyv3.RedisProxy_PrefixRoutes{},
+	}
+
+	for _, route := range spec.PrefixRoutes {
+		cluster, err := resolveCluster(route.BackendRef)
+		if err != nil {
+			return err
+		}
+		prefixRoute := &envoyredisproxyv3.RedisProxy_PrefixRoutes_Route{
+			Prefix:       route.Prefix,
+			RemovePrefix: route.RemovePrefix != nil && *route.RemovePrefix,
+			Cluster:      cluster,
+		}
+		if route.ReadBackendRef != nil {
+			readCluster, err := resolveCluster(*route.ReadBackendRef)
+			if err != nil {
+				return err
+			}
+			prefixRoute.ReadCommandPolicy = &envoyredisproxyv3.RedisProxy_PrefixRoutes_Route_ReadCommandPolicy{
+				Cluster: readCluster,
+			}
+		}
+		proxy.GetPrefixRoutes().Routes = append(proxy.GetPrefixRoutes().GetRoutes(), prefixRoute)
+	}
+
+	var readCluster string
+	if spec.ReadBackendRef != nil {
+		var err error
+		readCluster, err = resolveCluster(*spec.ReadBackendRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.DownstreamAuth != nil {
+		if err := applyRedisDownstreamAuth(krtctx, commoncol.Secrets, spec.DownstreamAuth, policyCR.Namespace, proxy); err != nil {
+			return err
+		}
+	}
+
+	out.redisProxy = &redisProxyIR{
+		proxy:       proxy,
+		readCluster: readCluster,
+	}
+	return nil
+}
+
+// applyRedisDownstreamAuth sets the credentials the clients authenticate with from the referenced Secret.
+func applyRedisDownstreamAuth(
+	krtctx krt.HandlerContext,
+	secrets *krtcollections.SecretIndex,
+	auth *kgateway.RedisDownstreamAuth,
+	policyNamespace string,
+	out *envoyredisproxyv3.RedisProxy,
+) error {
+	from := krtcollections.From{
+		GroupKind: kgwwellknown.TrafficPolicyGVK.GroupKind(),
+		Namespace: policyNamespace,
+	}
+	secret, err := secrets.GetSecret(krtctx, from, gwv1.SecretObjectReference{
+		Name: gwv1.ObjectName(auth.SecretRef.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("redisProxy: %w", err)
+	}
+
+	password := secret.Data[redisAuthPasswordKey]
+	if len(password) == 0 {
+		return fmt.Errorf("redisProxy: secret %s/%s does not contain key '%s'", policyNamespace, auth.SecretRef.Name, redisAuthPasswordKey)
+	}
+	out.DownstreamAuthPasswords = []*envoycorev3.DataSource{{
+		Specifier: &envoycorev3.DataSource_InlineBytes{
+			InlineBytes: password,
+		},
+	}}
+	if username := secret.Data[redisAuthUsernameKey]; len(username) > 0 {
+		out.DownstreamAuthUsername = &envoycorev3.DataSource{
+			Specifier: &envoycorev3.DataSource_InlineBytes{
+				InlineBytes: username,
+			},
+		}
+	}
+	return nil
+}
+
+// applyRedisProxy replaces the tcp_proxy filter of the filter chain with a Redis proxy filter.
+// The commands that don't match a prefix route are sent to the backend of the route.
+func applyRedisProxy(pCtx *ir.TcpRouteContext, redisProxy *redisProxyIR) error {
+	if len(pCtx.BackendRefs) != 1 {
+		return errRedisProxySingleBackend
+	}
+
+	proxy := proto.Clone(redisProxy.proxy).(*envoyredisproxyv3.RedisProxy)
+	proxy.StatPrefix = pCtx.FilterChainName
+	catchAll := &envoyredisproxyv3.RedisProxy_PrefixRoutes_Route{
+		Cluster: pCtx.BackendRefs[0].ClusterName,
+	}
+	if redisProxy.readCluster != "" {
+		catchAll.ReadCommandPolicy = &envoyredisproxyv3.RedisProxy_PrefixRoutes_Route_ReadCommandPolicy{
+			Cluster: redisProxy.readCluster,
+		}
+	}
+	proxy.GetPrefixRoutes().CatchAllRoute = catchAll
+
+	proxyAny, err := utils.MessageToAny(proxy)
+	if err != nil {
+		return err
+	}
+	pCtx.TerminalFilter = &envoylistenerv3.Filter{
+		Name: wellknown.RedisProxy,
+		ConfigType: &envoylistenerv3.Filter_TypedConfig{
+			TypedConfig: proxyAny,
+		},
+	}
+	return nil
+}
+
+// toRedisReadPolicy converts the read policy to the envoy read policy, which defaults to the primary nodes.
+func toRedisReadPolicy(in *kgateway.RedisReadPolicy) envoyredisproxyv3.RedisProxy_ConnPoolSettings_ReadPolicy {
+	if in == nil {
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_MASTER
+	}
+	switch *in {
+	case kgateway.RedisReadPolicyPreferPrimary:
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_PREFER_MASTER
+	case kgateway.RedisReadPolicyReplica:
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_REPLICA
+	case kgateway.RedisReadPolicyPreferReplica:
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_PREFER_REPLICA
+	case kgateway.RedisReadPolicyAny:
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_ANY
+	default:
+		return envoyredisproxyv3.RedisProxy_ConnPoolSettings_MASTER
+	}
+}
@@ -0,0 +1,45 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyredisproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyRedisProxy(t *testing.T) {
+	redisProxy := &redisProxyIR{
+		proxy: &envoyredisproxyv3.RedisProxy{
+			Settings: &envoyredisproxyv3.RedisProxy_ConnPoolSettings{
+				OpTimeout: durationpb.New(defaultRedisOperationTimeout),
+			},
+			PrefixRoutes: &envoyredisproxyv3.RedisProxy_PrefixRoutes{},
+		},
+		readCluster: "replicas",
+	}
+	require.NoError(t, redisProxy.Validate())
+
+	pCtx := &ir.TcpRouteContext{
+		FilterChainName: "redis",
+		BackendRefs:     []ir.BackendRefIR{{ClusterName: "primary"}},
+	}
+	require.NoError(t, applyRedisProxy(pCtx, redisProxy))
+	require.NotNil(t, pCtx.TerminalFilter)
+
+	out := &envoyredisproxyv3.RedisProxy{}
+	require.NoError(t, pCtx.TerminalFilter.GetTypedConfig().UnmarshalTo(out))
+	assert.Equal(t, "redis", out.GetStatPrefix())
+	assert.Equal(t, "primary", out.GetPrefixRoutes().GetCatchAllRoute().GetCluster())
+	assert.Equal(t, "replicas", out.GetPrefixRoutes().GetCatchAllRoute().GetReadCommandPolicy().GetCluster())
+	// the policy IR is shared between filter chains, so it must not be modified
+	assert.Nil(t, redisProxy.proxy.GetPrefixRoutes().GetCatchAllRoute())
+
+	err := applyRedisProxy(&ir.TcpRouteContext{
+		BackendRefs: []ir.BackendRefIR{{ClusterName: "a"}, {ClusterName: "b"}},
+	}, redisProxy)
+	assert.ErrorIs(t, err, errRedisProxySingleBackend)
+}
@@ -15,7 +15,8 @@ var (
 )
 
 type retryIR struct {
-	policy *envoyroutev3.RetryPolicy
+	policy      *envoyroutev3.RetryPolicy
+	hedgePolicy *envoyroutev3.HedgePolicy
 }
 
 func (a *retryIR) Equals(other PolicySubIR) bool {
@@ -26,14 +27,18 @@ func (a *retryIR) Equals(other PolicySubIR) bool {
 	if a == nil || b == nil {
 		return a == nil && b == nil
 	}
-	return proto.Equal(a.policy, b.policy)
+	return proto.Equal(a.policy, b.policy) &&
+		proto.Equal(a.hedgePolicy, b.hedgePolicy)
 }
 
 func (a *retryIR) Validate() error {
 	if a == nil || a.policy == nil {
 		return nil
 	}
-	return a.policy.Validate()
+	if err := a.policy.Validate(); err != nil {
+		return err
+	}
+	return a.hedgePolicy.Validate()
 }
 
 type timeoutsIR struct {
@@ -60,7 +65,7 @@ func (a *timeoutsIR) Validate() error {
 func constructTimeoutRetry(
 	spec kgateway.TrafficPolicySpec,
 	out *trafficPolicySpecIr,
-) {
+) error {
 	if spec.Timeouts != nil {
 		out.timeouts = &timeoutsIR{}
 		if spec.Timeouts.Request != nil {
@@ -72,8 +77,14 @@ func constructTimeoutRetry(
 	}
 
 	if spec.Retry != nil {
+		retryPolicy, err := policy.BuildRetryPolicy(spec.Retry)
+		if err != nil {
+			return err
+		}
 		out.retry = &retryIR{
-			policy: policy.BuildRetryPolicy(spec.Retry),
+			policy:      retryPolicy,
+			hedgePolicy: policy.BuildHedgePolicy(spec.Retry),
 		}
 	}
+	return nil
 }
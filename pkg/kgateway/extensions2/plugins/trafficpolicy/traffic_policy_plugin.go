@@ -2,6 +2,8 @@ package trafficpolicy
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
@@ -14,6 +16,7 @@ import (
 	envoy_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
 	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
 	dynamicmodulesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_modules/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
@@ -105,8 +108,25 @@ type trafficPolicySpecIr struct {
 	urlRewrite      *urlRewriteIR
 	apiKeyAuth      *apiKeyAuthIR
 	oauth2          *oauthIR
+	faultInjection  *faultInjectionIR
+	wasm            *wasmIR
+	cache           *cacheIR
+	bandwidthLimit  *bandwidthLimitIR
+	errorPages      *errorPagesIR
+
+	adaptiveConcurrency *loadSheddingIR
+	admissionControl    *loadSheddingIR
+
+	networkAuthorization *networkAuthorizationIR
+	udpSession           *udpSessionIR
+	redisProxy           *redisProxyIR
 }
 
+var (
+	_ ir.PolicyIR           = &TrafficPolicy{}
+	_ ir.WasmImagesPolicyIR = &TrafficPolicy{}
+)
+
 func (d *TrafficPolicy) CreationTime() time.Time {
 	return d.ct
 }
@@ -183,6 +203,36 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.oauth2.Equals(d2.spec.oauth2) {
 		return false
 	}
+	if !d.spec.faultInjection.Equals(d2.spec.faultInjection) {
+		return false
+	}
+	if !d.spec.wasm.Equals(d2.spec.wasm) {
+		return false
+	}
+	if !d.spec.cache.Equals(d2.spec.cache) {
+		return false
+	}
+	if !d.spec.bandwidthLimit.Equals(d2.spec.bandwidthLimit) {
+		return false
+	}
+	if !d.spec.errorPages.Equals(d2.spec.errorPages) {
+		return false
+	}
+	if !d.spec.adaptiveConcurrency.Equals(d2.spec.adaptiveConcurrency) {
+		return false
+	}
+	if !d.spec.admissionControl.Equals(d2.spec.admissionControl) {
+		return false
+	}
+	if !d.spec.networkAuthorization.Equals(d2.spec.networkAuthorization) {
+		return false
+	}
+	if !d.spec.udpSession.Equals(d2.spec.udpSession) {
+		return false
+	}
+	if !d.spec.redisProxy.Equals(d2.spec.redisProxy) {
+		return false
+	}
 	return true
 }
 
@@ -210,6 +260,16 @@ func (p *TrafficPolicy) Validate() error {
 	validators = append(validators, p.spec.urlRewrite.Validate)
 	validators = append(validators, p.spec.apiKeyAuth.Validate)
 	validators = append(validators, p.spec.oauth2.Validate)
+	validators = append(validators, p.spec.faultInjection.Validate)
+	validators = append(validators, p.spec.wasm.Validate)
+	validators = append(validators, p.spec.cache.Validate)
+	validators = append(validators, p.spec.bandwidthLimit.Validate)
+	validators = append(validators, p.spec.errorPages.Validate)
+	validators = append(validators, p.spec.adaptiveConcurrency.Validate)
+	validators = append(validators, p.spec.admissionControl.Validate)
+	validators = append(validators, p.spec.networkAuthorization.Validate)
+	validators = append(validators, p.spec.udpSession.Validate)
+	validators = append(validators, p.spec.redisProxy.Validate)
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -225,6 +285,7 @@ type trafficPolicyPluginGwPass struct {
 	setTransformationInChain map[string]bool // TODO(nfuden): make this multi stage
 	listenerTransform        *transformationpb.RouteTransformations
 	localRateLimitInChain    map[string]*localratelimitv3.LocalRateLimit
+	sharedRateLimitInChain   map[string]map[string]*localratelimitv3.LocalRateLimit
 	extAuthPerProvider       ProviderNeededMap
 	extProcPerProvider       ProviderNeededMap
 	jwtPerProvider           ProviderNeededMap
@@ -239,6 +300,13 @@ type trafficPolicyPluginGwPass struct {
 	decompressorInChain      map[string]*decompressorv3.Decompressor
 	basicAuthInChain         map[string]*envoy_basic_auth_v3.BasicAuth
 	apiKeyAuthInChain        map[string]*envoy_api_key_auth_v3.ApiKeyAuth
+	faultInChain             map[string]*faultv3.HTTPFault
+	wasmInChain              map[string]map[string]*wasmPluginIR
+	cacheInChain             map[string]map[string]*cacheIR
+	uncacheableRoutes        map[uncacheableRoutesKey][]string
+	bandwidthLimitInChain    map[string]bool
+	errorPagesInChain        map[string]*errorPagesMappers
+	loadSheddingInChain      map[string]map[string]*loadSheddingIR
 	// maps secret name to secret in case the same secret is referenced in multiple attachment points (e.g., vhost and route)
 	secrets map[string]*envoytlsv3.Secret
 }
@@ -360,6 +428,7 @@ func (p *trafficPolicyPluginGwPass) ApplyRouteConfigPlugin(
 	}
 
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	p.handleErrorPages(pCtx.FilterChainName, policy.spec.errorPages)
 }
 
 func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
@@ -373,6 +442,7 @@ func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
 
 	p.handlePerVHostPolicies(policy.spec, out)
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	p.handleVhostErrorPages(pCtx.FilterChainName, out.GetName(), policy.spec.errorPages)
 }
 
 // called 0 or more times
@@ -384,6 +454,8 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, outputR
 
 	p.handlePerRoutePolicies(policy.spec, outputRoute)
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	p.handleRouteErrorPages(pCtx.FilterChainName, outputRoute.GetName(), policy.spec.errorPages)
+	p.reportUncacheableRoute(pCtx, outputRoute, policy.spec.cache)
 
 	return nil
 }
@@ -408,6 +480,53 @@ func (p *trafficPolicyPluginGwPass) ApplyForRouteBackend(
 func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
 	stagedFilters := []filters.StagedHttpFilter{}
 
+	// Add Fault filter to enable fault injection for the listener.
+	// Requires the fault policy to be set as typed_per_filter_config.
+	if f := p.faultInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(faultFilterName, f, filters.DuringStage(filters.FaultStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add global Wasm disable filter when there are plugins
+	if len(p.wasmInChain[fcc.FilterChainName]) > 0 {
+		// register the filter that sets metadata so that it can have overrides on the route level
+		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, wasmGlobalDisableFilterName, wasmGlobalDisableFilterMetadataNamespace)
+	}
+	// Add a Wasm filter for each plugin configuration. The filters are enabled on the routes using them.
+	for _, plugin := range p.wasmInChain[fcc.FilterChainName] {
+		stagedWasmFilter := filters.MustNewStagedFilterWithWeight(
+			plugin.filterName,
+			plugin.filter,
+			plugin.stage,
+			plugin.weight,
+		)
+		stagedWasmFilter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, stagedWasmFilter)
+	}
+
+	// Add global cache disable filter when there are cache filters
+	if len(p.cacheInChain[fcc.FilterChainName]) > 0 {
+		// register the filter that sets metadata so that it can have overrides on the route level
+		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, cacheGlobalDisableFilterName, cacheGlobalDisableFilterMetadataNamespace)
+	}
+	// Add a cache filter for each cache configuration. The filters are enabled on the routes using them.
+	for _, cache := range p.cacheInChain[fcc.FilterChainName] {
+		stagedCacheFilter := filters.MustNewStagedFilter(cache.filterName, cache.filter, cacheFilterStage)
+		stagedCacheFilter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, stagedCacheFilter)
+	}
+
+	// Add the bandwidth limit filters for requests and responses to enable bandwidth limiting for the listener.
+	// Requires the bandwidth limit policy to be set as typed_per_filter_config.
+	if p.bandwidthLimitInChain[fcc.FilterChainName] {
+		stagedFilters = append(stagedFilters, bandwidthLimitFilters()...)
+	}
+
+	// Add a filter for each adaptive concurrency and admission control configuration.
+	// The filters are enabled on the routes using them.
+	stagedFilters = p.loadSheddingFilters(fcc.FilterChainName, stagedFilters)
+
 	// Add global ExtProc disable filter when there are providers
 	if len(p.extProcPerProvider.Providers[fcc.FilterChainName]) > 0 {
 		// register the filter that sets metadata so that it can have overrides on the route level
@@ -544,6 +663,12 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.
 		filter.Filter.Disabled = true
 		stagedFilters = append(stagedFilters, filter)
 	}
+	// Add a local rate limit filter for each share key. The filters are enabled on the routes using them.
+	for _, name := range slices.Sorted(maps.Keys(p.sharedRateLimitInChain[fcc.FilterChainName])) {
+		filter := filters.MustNewStagedFilter(name, p.sharedRateLimitInChain[fcc.FilterChainName][name], filters.BeforeStage(filters.AcceptedStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
 
 	// Add global rate limit filters from providers
 	for _, provider := range p.rateLimitPerProvider.Providers[fcc.FilterChainName] {
@@ -659,6 +784,12 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(
 	p.handleBasicAuth(fcn, typedFilterConfig, spec.basicAuth)
 	p.handleAPIKeyAuth(fcn, typedFilterConfig, spec.apiKeyAuth)
 	p.handleOauth2(fcn, typedFilterConfig, spec.oauth2)
+	p.handleFaultInjection(fcn, typedFilterConfig, spec.faultInjection)
+	p.handleWasm(fcn, typedFilterConfig, spec.wasm)
+	p.handleCache(fcn, typedFilterConfig, spec.cache)
+	p.handleBandwidthLimit(fcn, typedFilterConfig, spec.bandwidthLimit)
+	p.handleLoadShedding(fcn, typedFilterConfig, spec.adaptiveConcurrency)
+	p.handleLoadShedding(fcn, typedFilterConfig, spec.admissionControl)
 }
 
 // handlePerRoutePolicies handles policies that are meant to be processed at the route level
@@ -695,6 +826,7 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 	// set by the builtin HTTPRouteRetry policy
 	if action.GetRetryPolicy() == nil && spec.retry != nil {
 		action.RetryPolicy = spec.retry.policy
+		action.HedgePolicy = spec.retry.hedgePolicy
 	}
 
 	// Apply URL rewrite configuration
@@ -708,6 +840,7 @@ func (p *trafficPolicyPluginGwPass) handlePerVHostPolicies(
 ) {
 	if spec.retry != nil {
 		out.RetryPolicy = spec.retry.policy
+		out.HedgePolicy = spec.retry.hedgePolicy
 	}
 }
 
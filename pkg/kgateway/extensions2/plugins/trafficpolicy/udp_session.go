@@ -0,0 +1,91 @@
+package trafficpolicy
+
+import (
+	envoyudpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// udpSessionIR is the internal representation of the session settings of a UDPRoute.
+type udpSessionIR struct {
+	idleTimeout  *durationpb.Duration
+	hashPolicies []*envoyudpproxyv3.UdpProxyConfig_HashPolicy
+}
+
+func (u *udpSessionIR) Equals(other *udpSessionIR) bool {
+	if u == nil && other == nil {
+		return true
+	}
+	if u == nil || other == nil {
+		return false
+	}
+	if !proto.Equal(u.idleTimeout, other.idleTimeout) {
+		return false
+	}
+	if len(u.hashPolicies) != len(other.hashPolicies) {
+		return false
+	}
+	for i := range u.hashPolicies {
+		if !proto.Equal(u.hashPolicies[i], other.hashPolicies[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate performs validation on the udp session component.
+func (u *udpSessionIR) Validate() error {
+	if u == nil {
+		return nil
+	}
+	if u.idleTimeout != nil {
+		if err := u.idleTimeout.CheckValid(); err != nil {
+			return err
+		}
+	}
+	for _, hashPolicy := range u.hashPolicies {
+		if err := hashPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructUDPSession constructs the udp session policy IR from the policy specification.
+func constructUDPSession(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.UDPSession == nil {
+		return
+	}
+
+	session := &udpSessionIR{}
+	if spec.UDPSession.IdleTimeout != nil {
+		session.idleTimeout = durationpb.New(spec.UDPSession.IdleTimeout.Duration)
+	}
+	if spec.UDPSession.Affinity != nil && *spec.UDPSession.Affinity == kgateway.UDPSessionAffinitySourceIP {
+		session.hashPolicies = []*envoyudpproxyv3.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &envoyudpproxyv3.UdpProxyConfig_HashPolicy_SourceIp{
+				SourceIp: true,
+			},
+		}}
+	}
+	out.udpSession = session
+}
+
+// ApplyForUdpRoute sets the session settings of policies attached to UDPRoutes.
+func (p *trafficPolicyPluginGwPass) ApplyForUdpRoute(pCtx *ir.UdpRouteContext, out *envoyudpproxyv3.UdpProxyConfig) error {
+	policy, ok := pCtx.Policy.(*TrafficPolicy)
+	if !ok || policy.spec.udpSession == nil {
+		return nil
+	}
+
+	if policy.spec.udpSession.idleTimeout != nil {
+		out.IdleTimeout = policy.spec.udpSession.idleTimeout
+	}
+	if len(policy.spec.udpSession.hashPolicies) > 0 {
+		out.HashPolicies = policy.spec.udpSession.hashPolicies
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyudpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyUDPSession(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructUDPSession(kgateway.TrafficPolicySpec{
+		UDPSession: &kgateway.UDPSession{
+			IdleTimeout: &metav1.Duration{Duration: 30 * time.Second},
+			Affinity:    ptr.To(kgateway.UDPSessionAffinitySourceIP),
+		},
+	}, out)
+	require.NotNil(t, out.udpSession)
+	require.NoError(t, out.udpSession.Validate())
+
+	cfg := &envoyudpproxyv3.UdpProxyConfig{}
+	p := &trafficPolicyPluginGwPass{}
+	err := p.ApplyForUdpRoute(&ir.UdpRouteContext{Policy: &TrafficPolicy{spec: *out}}, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cfg.GetIdleTimeout().AsDuration())
+	require.Len(t, cfg.GetHashPolicies(), 1)
+	assert.True(t, cfg.GetHashPolicies()[0].GetSourceIp())
+
+	other := &trafficPolicySpecIr{}
+	constructUDPSession(kgateway.TrafficPolicySpec{
+		UDPSession: &kgateway.UDPSession{
+			IdleTimeout: &metav1.Duration{Duration: 30 * time.Second},
+		},
+	}, other)
+	assert.False(t, out.udpSession.Equals(other.udpSession))
+	assert.Empty(t, other.udpSession.hashPolicies)
+}
@@ -0,0 +1,342 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"slices"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoywasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoywasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	// wasmFilterPrefix is the prefix for the Wasm filter names
+	wasmFilterPrefix = "wasm/"
+
+	// wasmGlobalDisableFilterName is the name of the filter for Wasm that disables all Wasm plugins
+	wasmGlobalDisableFilterName = "global_disable/wasm"
+
+	// wasmGlobalDisableFilterMetadataNamespace is the metadata namespace for the global disable Wasm filter
+	wasmGlobalDisableFilterMetadataNamespace = "dev.kgateway.disable_wasm"
+
+	wasmRuntimeV8 = "envoy.wasm.runtime.v8"
+)
+
+type wasmIR struct {
+	plugins    []*wasmPluginIR
+	disableAll bool
+}
+
+// wasmPluginIR is a Wasm plugin together with its configuration for the policy targets.
+// The Envoy Wasm filter does not support per-route configuration, so every unique
+// plugin configuration runs in its own filter that is enabled on the routes using it.
+type wasmPluginIR struct {
+	// filterName is the unique name of the filter running this plugin configuration
+	filterName string
+	stage      filters.HTTPFilterStage
+	// weight orders plugins within the same stage in the order they are listed in the policy
+	weight int32
+	filter *envoymatchingv3.ExtensionWithMatcher
+	// image is the OCI image of the module, if it is distributed as one
+	image *ir.WasmImage
+}
+
+var _ PolicySubIR = &wasmIR{}
+
+func (w *wasmIR) Equals(other PolicySubIR) bool {
+	otherWasm, ok := other.(*wasmIR)
+	if !ok {
+		return false
+	}
+	if w == nil || otherWasm == nil {
+		return w == nil && otherWasm == nil
+	}
+	if w.disableAll != otherWasm.disableAll {
+		return false
+	}
+	return slices.EqualFunc(w.plugins, otherWasm.plugins, func(a, b *wasmPluginIR) bool {
+		return a.filterName == b.filterName &&
+			a.stage == b.stage &&
+			a.weight == b.weight &&
+			proto.Equal(a.filter, b.filter) &&
+			ptr.Equal(a.image, b.image)
+	})
+}
+
+func (w *wasmIR) Validate() error {
+	if w == nil {
+		return nil
+	}
+	for _, p := range w.plugins {
+		if err := p.filter.ValidateAll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructWasm constructs the Wasm policy IR from the policy specification.
+func constructWasm(
+	krtctx krt.HandlerContext,
+	in *kgateway.TrafficPolicy,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
+	out *trafficPolicySpecIr,
+) error {
+	spec := in.Spec.Wasm
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.wasm = &wasmIR{
+			disableAll: true,
+		}
+		return nil
+	}
+
+	plugins := make([]*wasmPluginIR, 0, len(spec.Plugins))
+	for i, plugin := range spec.Plugins {
+		gatewayExtension, err := fetchGatewayExtension(krtctx, plugin.ExtensionRef, in.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("wasm: %w", err)
+		}
+		if gatewayExtension.Wasm == nil {
+			return pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeWasm)
+		}
+
+		pluginConfig := proto.Clone(gatewayExtension.Wasm).(*envoywasmv3.PluginConfig)
+		var configuration []byte
+		if plugin.Config != nil && len(plugin.Config.Raw) > 0 {
+			configuration = plugin.Config.Raw
+			pluginConfig.Configuration = utils.MustMessageToAny(wrapperspb.String(string(configuration)))
+		}
+		stage := toFilterStage(plugin.Stage)
+
+		plugins = append(plugins, &wasmPluginIR{
+			filterName: wasmFilterName(providerName(gatewayExtension), configuration, stage),
+			stage:      stage,
+			weight:     int32(len(spec.Plugins) - i), // nolint:gosec // G115: kubebuilder validation limits the number of plugins
+			filter: buildCompositeFilter(
+				"composite_wasm",
+				wasmGlobalDisableFilterMetadataNamespace,
+				&envoycorev3.TypedExtensionConfig{
+					Name:        "envoy.filters.http.wasm",
+					TypedConfig: utils.MustMessageToAny(&envoywasmfilterv3.Wasm{Config: pluginConfig}),
+				},
+			),
+			image: gatewayExtension.WasmImage,
+		})
+	}
+	out.wasm = &wasmIR{
+		plugins: plugins,
+	}
+	return nil
+}
+
+// WasmImages returns the images of the Wasm modules run by the policy, so that the deployer
+// mounts them into the proxy pods of the Gateways the policy applies to.
+func (p *TrafficPolicy) WasmImages() []ir.WasmImage {
+	if p.spec.wasm == nil {
+		return nil
+	}
+	var images []ir.WasmImage
+	for _, plugin := range p.spec.wasm.plugins {
+		if plugin.image != nil {
+			images = append(images, *plugin.image)
+		}
+	}
+	return images
+}
+
+// wasmFilterName returns a filter name that is unique for the provider, plugin configuration and stage.
+func wasmFilterName(providerName string, configuration []byte, stage filters.HTTPFilterStage) string {
+	h := fnv.New64a()
+	h.Write(configuration)
+	fmt.Fprintf(h, "/%d/%d", stage.RelativeTo, stage.RelativeWeight)
+	return fmt.Sprintf("%s%s/%016x", wasmFilterPrefix, providerName, h.Sum64())
+}
+
+// toFilterStage converts the API filter stage to the HTTP filter stage, defaulting to the Accepted stage.
+func toFilterStage(in *kgateway.FilterStage) filters.HTTPFilterStage {
+	if in == nil {
+		return filters.DuringStage(filters.AcceptedStage)
+	}
+
+	var wellKnown filters.WellKnownFilterStage
+	switch in.Name {
+	case kgateway.FilterStageFault:
+		wellKnown = filters.FaultStage
+	case kgateway.FilterStageCors:
+		wellKnown = filters.CorsStage
+	case kgateway.FilterStageWaf:
+		wellKnown = filters.WafStage
+	case kgateway.FilterStageAuthN:
+		wellKnown = filters.AuthNStage
+	case kgateway.FilterStageAuthZ:
+		wellKnown = filters.AuthZStage
+	case kgateway.FilterStageRateLimit:
+		wellKnown = filters.RateLimitStage
+	case kgateway.FilterStageOutAuth:
+		wellKnown = filters.OutAuthStage
+	case kgateway.FilterStageRoute:
+		wellKnown = filters.RouteStage
+	default:
+		wellKnown = filters.AcceptedStage
+	}
+
+	switch ptr.Deref(in.Placement, kgateway.FilterStagePlacementDuring) {
+	case kgateway.FilterStagePlacementBefore:
+		return filters.BeforeStage(wellKnown)
+	case kgateway.FilterStagePlacementAfter:
+		return filters.AfterStage(wellKnown)
+	default:
+		return filters.DuringStage(wellKnown)
+	}
+}
+
+// buildWasmPluginConfig builds the plugin configuration shared by all filters running the
+// module of a Wasm GatewayExtension.
+func buildWasmPluginConfig(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	gExt ir.GatewayExtension,
+) (*envoywasmv3.PluginConfig, error) {
+	code, err := resolveWasmModule(krtctx, commoncol, gExt)
+	if err != nil {
+		return nil, err
+	}
+
+	name := krt.Named{Name: gExt.Name, Namespace: gExt.Namespace}.ResourceName()
+	failurePolicy := envoywasmv3.FailurePolicy_FAIL_CLOSED
+	if gExt.Wasm.FailOpen {
+		failurePolicy = envoywasmv3.FailurePolicy_FAIL_OPEN
+	}
+	return &envoywasmv3.PluginConfig{
+		Name:   name,
+		RootId: ptr.Deref(gExt.Wasm.RootID, ""),
+		Vm: &envoywasmv3.PluginConfig_VmConfig{
+			VmConfig: &envoywasmv3.VmConfig{
+				VmId:    name,
+				Runtime: wasmRuntimeV8,
+				Code:    code,
+			},
+		},
+		FailurePolicy: failurePolicy,
+	}, nil
+}
+
+func resolveWasmModule(
+	krtctx krt.HandlerContext,
+	commoncol *collections.CommonCollections,
+	gExt ir.GatewayExtension,
+) (*envoycorev3.AsyncDataSource, error) {
+	module := gExt.Wasm.Module
+	switch {
+	case module.Image != nil:
+		// the image is mounted into the proxy pods by the deployer
+		return &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Local{
+				Local: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{
+						Filename: path.Join(
+							wellknown.WasmModuleMountPath,
+							gExt.Namespace,
+							gExt.Name,
+							ptr.Deref(module.Image.Path, wellknown.DefaultWasmModuleFile),
+						),
+					},
+				},
+			},
+		}, nil
+
+	case module.HTTP != nil:
+		backend, err := resolveBackend(krtctx, commoncol.BackendIndex, false, gExt.ObjectSource, module.HTTP.BackendRef.BackendObjectReference)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve module backend: %w", err)
+		}
+		if backend == nil || backend.ClusterName() == "" {
+			return nil, errors.New("module backend not found")
+		}
+		timeout := kgateway.HTTPDefaultTimeout
+		if module.HTTP.Timeout != nil {
+			timeout = module.HTTP.Timeout.Duration
+		}
+		return &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Remote{
+				Remote: &envoycorev3.RemoteDataSource{
+					HttpUri: &envoycorev3.HttpUri{
+						Uri: module.HTTP.URL,
+						HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+							Cluster: backend.ClusterName(),
+						},
+						Timeout: durationpb.New(timeout),
+					},
+					Sha256: module.HTTP.Sha256,
+				},
+			},
+		}, nil
+
+	case module.ConfigMap != nil:
+		cm, err := GetConfigMap(krtctx, commoncol.ConfigMaps.Collection(), string(module.ConfigMap.Name), gExt.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		key := ptr.Deref(module.ConfigMap.Key, wellknown.DefaultWasmModuleFile)
+		data, ok := cm.BinaryData[key]
+		if !ok || len(data) == 0 {
+			return nil, fmt.Errorf("configmap %s/%s does not contain binary data for key '%s'", cm.Namespace, cm.Name, key)
+		}
+		return &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Local{
+				Local: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_InlineBytes{
+						InlineBytes: data,
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, errors.New("one of image, http or configMap must be set for the Wasm module")
+}
+
+func (p *trafficPolicyPluginGwPass) handleWasm(filterChain string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, in *wasmIR) {
+	if in == nil {
+		return
+	}
+
+	// Enable the global disable filter to skip all Wasm plugins
+	if in.disableAll {
+		pCtxTypedFilterConfig.AddTypedConfig(wasmGlobalDisableFilterName, EnableFilterPerRoute())
+		return
+	}
+
+	if p.wasmInChain == nil {
+		p.wasmInChain = make(map[string]map[string]*wasmPluginIR)
+	}
+	if p.wasmInChain[filterChain] == nil {
+		p.wasmInChain[filterChain] = make(map[string]*wasmPluginIR)
+	}
+	for _, plugin := range in.plugins {
+		if _, ok := p.wasmInChain[filterChain][plugin.filterName]; !ok {
+			p.wasmInChain[filterChain][plugin.filterName] = plugin
+		}
+		pCtxTypedFilterConfig.AddTypedConfig(plugin.filterName, EnableFilterPerRoute())
+	}
+}
@@ -0,0 +1,183 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoywasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoywasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestToFilterStage(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *kgateway.FilterStage
+		want filters.HTTPFilterStage
+	}{
+		{
+			name: "defaults to during the accepted stage",
+			want: filters.DuringStage(filters.AcceptedStage),
+		},
+		{
+			name: "placement defaults to during",
+			in:   &kgateway.FilterStage{Name: kgateway.FilterStageAuthN},
+			want: filters.DuringStage(filters.AuthNStage),
+		},
+		{
+			name: "before stage",
+			in: &kgateway.FilterStage{
+				Name:      kgateway.FilterStageFault,
+				Placement: ptr.To(kgateway.FilterStagePlacementBefore),
+			},
+			want: filters.BeforeStage(filters.FaultStage),
+		},
+		{
+			name: "after stage",
+			in: &kgateway.FilterStage{
+				Name:      kgateway.FilterStageRoute,
+				Placement: ptr.To(kgateway.FilterStagePlacementAfter),
+			},
+			want: filters.AfterStage(filters.RouteStage),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, toFilterStage(tt.in))
+		})
+	}
+}
+
+func TestConstructWasm(t *testing.T) {
+	wasmExtension := &TrafficPolicyGatewayExtensionIR{
+		Name: "default/signer",
+		Wasm: &envoywasmv3.PluginConfig{
+			Name:   "default/signer",
+			RootId: "signer",
+		},
+	}
+	fetchGatewayExtension := func(_ krt.HandlerContext, ref shared.NamespacedObjectReference, _ string) (*TrafficPolicyGatewayExtensionIR, error) {
+		if ref.Name == "signer" {
+			return wasmExtension, nil
+		}
+		return &TrafficPolicyGatewayExtensionIR{Name: "default/" + string(ref.Name)}, nil
+	}
+	policy := func(wasm *kgateway.WasmPolicy) *kgateway.TrafficPolicy {
+		return &kgateway.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+			Spec:       kgateway.TrafficPolicySpec{Wasm: wasm},
+		}
+	}
+
+	t.Run("plugins with configuration", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, policy(&kgateway.WasmPolicy{
+			Plugins: []kgateway.WasmPlugin{
+				{
+					ExtensionRef: shared.NamespacedObjectReference{Name: "signer"},
+					Config:       &apiextensionsv1.JSON{Raw: []byte(`{"key":"a"}`)},
+				},
+				{
+					ExtensionRef: shared.NamespacedObjectReference{Name: "signer"},
+					Config:       &apiextensionsv1.JSON{Raw: []byte(`{"key":"b"}`)},
+					Stage:        &kgateway.FilterStage{Name: kgateway.FilterStageAuthN},
+				},
+			},
+		}), fetchGatewayExtension, out)
+		require.NoError(t, err)
+		require.NoError(t, out.wasm.Validate())
+		require.Len(t, out.wasm.plugins, 2)
+
+		first, second := out.wasm.plugins[0], out.wasm.plugins[1]
+		assert.NotEqual(t, first.filterName, second.filterName)
+		assert.Greater(t, first.weight, second.weight)
+		assert.Equal(t, filters.DuringStage(filters.AcceptedStage), first.stage)
+		assert.Equal(t, filters.DuringStage(filters.AuthNStage), second.stage)
+
+		// the per-route configuration must not leak into the shared extension
+		assert.Nil(t, wasmExtension.Wasm.GetConfiguration())
+
+		wantConfig := proto.Clone(wasmExtension.Wasm).(*envoywasmv3.PluginConfig)
+		wantConfig.Configuration = utils.MustMessageToAny(wrapperspb.String(`{"key":"a"}`))
+		action := first.filter.GetXdsMatcher().GetMatcherList().GetMatchers()[0].GetOnMatch().GetAction()
+		executeAction := &envoycompositev3.ExecuteFilterAction{}
+		require.NoError(t, action.GetTypedConfig().UnmarshalTo(executeAction))
+		gotFilter := &envoywasmfilterv3.Wasm{}
+		require.NoError(t, executeAction.GetTypedConfig().GetTypedConfig().UnmarshalTo(gotFilter))
+		assert.True(t, proto.Equal(wantConfig, gotFilter.GetConfig()), "unexpected plugin config: %v", gotFilter.GetConfig())
+	})
+
+	t.Run("same configuration yields the same filter name", func(t *testing.T) {
+		out1, out2 := &trafficPolicySpecIr{}, &trafficPolicySpecIr{}
+		spec := &kgateway.WasmPolicy{
+			Plugins: []kgateway.WasmPlugin{{
+				ExtensionRef: shared.NamespacedObjectReference{Name: "signer"},
+				Config:       &apiextensionsv1.JSON{Raw: []byte(`{"key":"a"}`)},
+			}},
+		}
+		require.NoError(t, constructWasm(nil, policy(spec), fetchGatewayExtension, out1))
+		require.NoError(t, constructWasm(nil, policy(spec), fetchGatewayExtension, out2))
+		assert.Equal(t, out1.wasm.plugins[0].filterName, out2.wasm.plugins[0].filterName)
+		assert.True(t, out1.wasm.Equals(out2.wasm))
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, policy(&kgateway.WasmPolicy{
+			Disable: &shared.PolicyDisable{},
+		}), fetchGatewayExtension, out)
+		require.NoError(t, err)
+		assert.True(t, out.wasm.disableAll)
+	})
+
+	t.Run("wrong extension type", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, policy(&kgateway.WasmPolicy{
+			Plugins: []kgateway.WasmPlugin{{
+				ExtensionRef: shared.NamespacedObjectReference{Name: "extproc"},
+			}},
+		}), fetchGatewayExtension, out)
+		require.Error(t, err)
+		assert.Nil(t, out.wasm)
+	})
+}
+
+func TestHandleWasm(t *testing.T) {
+	plugin := &wasmPluginIR{
+		filterName: "wasm/default/signer/0000000000000001",
+		stage:      filters.DuringStage(filters.AcceptedStage),
+		weight:     1,
+	}
+
+	t.Run("plugins are enabled on the route and added to the chain", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleWasm("fc", &typedFilterConfig, &wasmIR{plugins: []*wasmPluginIR{plugin}})
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[plugin.filterName]))
+		assert.Same(t, plugin, pass.wasmInChain["fc"][plugin.filterName])
+	})
+
+	t.Run("disable enables the global disable filter", func(t *testing.T) {
+		pass := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		pass.handleWasm("fc", &typedFilterConfig, &wasmIR{disableAll: true})
+
+		assert.True(t, proto.Equal(EnableFilterPerRoute(), typedFilterConfig[wasmGlobalDisableFilterName]))
+		assert.Empty(t, pass.wasmInChain["fc"])
+	})
+}
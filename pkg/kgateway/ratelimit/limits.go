@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// DescriptorIDKey is the key of the descriptor entry identifying the TrafficPolicy descriptor
+// that generated a rate limit descriptor. It is prepended to the descriptors sent to the built-in
+// service so that the service can look up the limit of the descriptor.
+const DescriptorIDKey = "kgateway.dev/descriptor"
+
+// DescriptorID returns the identifier of the descriptor at the given index of a TrafficPolicy.
+func DescriptorID(namespace, name string, index int) string {
+	return fmt.Sprintf("%s/%s/%d", namespace, name, index)
+}
+
+// ConnectionDescriptorID returns the identifier of the per-source connection rate limit of a ListenerPolicy
+// for the given port, or for its default listener configuration if the port is 0.
+func ConnectionDescriptorID(namespace, name string, port int32) string {
+	return fmt.Sprintf("ListenerPolicy/%s/%s/%d", namespace, name, port)
+}
+
+// DescriptorLimit is the limit of a TrafficPolicy global rate limit descriptor.
+type DescriptorLimit struct {
+	// ID identifies the descriptor, see DescriptorID
+	ID       string
+	Requests uint32
+	Unit     kgateway.RateLimitUnit
+}
+
+func (l DescriptorLimit) ResourceName() string {
+	return l.ID
+}
+
+func (l DescriptorLimit) Equals(other DescriptorLimit) bool {
+	return l == other
+}
+
+// window returns the duration of the time window of the limit.
+func (l DescriptorLimit) window() time.Duration {
+	switch l.Unit {
+	case kgateway.RateLimitUnitMinute:
+		return time.Minute
+	case kgateway.RateLimitUnitHour:
+		return time.Hour
+	case kgateway.RateLimitUnitDay:
+		return 24 * time.Hour
+	default:
+		return time.Second
+	}
+}
+
+// NewDescriptorLimitsCollection returns the limits of the global rate limit descriptors of the TrafficPolicies.
+func NewDescriptorLimitsCollection(
+	trafficPolicies krt.Collection[*kgateway.TrafficPolicy],
+	opts ...krt.CollectionOption,
+) krt.Collection[DescriptorLimit] {
+	return krt.NewManyCollection(trafficPolicies, func(_ krt.HandlerContext, policy *kgateway.TrafficPolicy) []DescriptorLimit {
+		if policy.Spec.RateLimit == nil || policy.Spec.RateLimit.Global == nil {
+			return nil
+		}
+		var limits []DescriptorLimit
+		for i, descriptor := range policy.Spec.RateLimit.Global.Descriptors {
+			if descriptor.Limit == nil {
+				continue
+			}
+			limits = append(limits, DescriptorLimit{
+				ID:       DescriptorID(policy.Namespace, policy.Name, i),
+				Requests: uint32(descriptor.Limit.Requests), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+				Unit:     descriptor.Limit.Unit,
+			})
+		}
+		return limits
+	}, opts...)
+}
+
+// NewConnectionLimitsCollection returns the limits of the per-source connection rate limits of the ListenerPolicies.
+func NewConnectionLimitsCollection(
+	listenerPolicies krt.Collection[*kgateway.ListenerPolicy],
+	opts ...krt.CollectionOption,
+) krt.Collection[DescriptorLimit] {
+	return krt.NewManyCollection(listenerPolicies, func(_ krt.HandlerContext, policy *kgateway.ListenerPolicy) []DescriptorLimit {
+		var limits []DescriptorLimit
+		addLimit := func(port int32, config *kgateway.ListenerConfig) {
+			if config == nil || config.Connections == nil || config.Connections.PerSourceRateLimit == nil {
+				return
+			}
+			limits = append(limits, DescriptorLimit{
+				ID:       ConnectionDescriptorID(policy.Namespace, policy.Name, port),
+				Requests: uint32(config.Connections.PerSourceRateLimit.Requests), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+				Unit:     config.Connections.PerSourceRateLimit.Unit,
+			})
+		}
+		addLimit(0, policy.Spec.Default)
+		for _, portConfig := range policy.Spec.PerPort {
+			addLimit(portConfig.Port, &portConfig.Listener)
+		}
+		return limits
+	}, opts...)
+}
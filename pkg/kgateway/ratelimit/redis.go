@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStore is a Store keeping the counters in Redis, or any server implementing the Redis protocol,
+// so that the counters are shared by all the controller replicas.
+type redisStore struct {
+	client *redis.Client
+}
+
+var _ Store = &redisStore{}
+
+// NewRedisStore returns a Store keeping the counters in the Redis server of the URL,
+// in the `redis://[[username]:password@]host:port[/db]` format. The `rediss` scheme enables TLS.
+func NewRedisStore(redisURL string) (Store, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	return &redisStore{client: redis.NewClient(opts)}, nil
+}
+
+func (s *redisStore) Increment(ctx context.Context, key string, hits uint32, expiration time.Duration) (uint64, error) {
+	var incr *redis.IntCmd
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, key, int64(hits))
+		pipe.PExpire(ctx, key, expiration)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment redis counter: %w", err)
+	}
+	value := incr.Val()
+	if value < 0 {
+		return 0, fmt.Errorf("unexpected redis INCRBY reply: %d", value)
+	}
+	return uint64(value), nil
+}
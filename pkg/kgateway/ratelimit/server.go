@@ -0,0 +1,176 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoyrlsv3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+)
+
+var logger = logging.New("ratelimit")
+
+// Server is the built-in global rate limit service, implementing the Envoy rate limit service API
+// with fixed window counters. The limits of the descriptors are defined by the TrafficPolicies.
+type Server struct {
+	envoyrlsv3.UnimplementedRateLimitServiceServer
+
+	store  Store
+	limits atomic.Pointer[krt.Collection[DescriptorLimit]]
+	now    func() time.Time
+}
+
+var _ envoyrlsv3.RateLimitServiceServer = &Server{}
+
+// NewServer returns a rate limit service keeping the counters in the store.
+// The service fails all requests until SetLimits is called and the limits are synced, so that the
+// requests aren't allowed without being counted, unless the rate limit filters fail open.
+func NewServer(store Store) *Server {
+	return &Server{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Register registers the service on the gRPC server.
+func (s *Server) Register(grpcServer *grpc.Server) {
+	envoyrlsv3.RegisterRateLimitServiceServer(grpcServer, s)
+}
+
+// SetLimits sets the limits of the descriptors.
+func (s *Server) SetLimits(limits krt.Collection[DescriptorLimit]) {
+	s.limits.Store(&limits)
+}
+
+func (s *Server) ShouldRateLimit(ctx context.Context, req *envoyrlsv3.RateLimitRequest) (*envoyrlsv3.RateLimitResponse, error) {
+	limits := s.limits.Load()
+	if limits == nil || !(*limits).HasSynced() {
+		return nil, status.Error(codes.Unavailable, "rate limits are not synced yet")
+	}
+
+	hits := req.GetHitsAddend()
+	if hits == 0 {
+		hits = 1
+	}
+
+	resp := &envoyrlsv3.RateLimitResponse{
+		OverallCode: envoyrlsv3.RateLimitResponse_OK,
+	}
+	for _, descriptor := range req.GetDescriptors() {
+		descriptorStatus, err := s.apply(ctx, *limits, req.GetDomain(), descriptor, hits)
+		if err != nil {
+			logger.Error("failed to apply rate limit", "domain", req.GetDomain(), "error", err)
+			return nil, status.Errorf(codes.Unavailable, "failed to apply rate limit: %v", err)
+		}
+		if descriptorStatus.GetCode() == envoyrlsv3.RateLimitResponse_OVER_LIMIT {
+			resp.OverallCode = envoyrlsv3.RateLimitResponse_OVER_LIMIT
+		}
+		resp.Statuses = append(resp.Statuses, descriptorStatus)
+	}
+	return resp, nil
+}
+
+// apply counts the hits of a descriptor and returns its status.
+// Descriptors without a limit are always allowed.
+func (s *Server) apply(
+	ctx context.Context,
+	limits krt.Collection[DescriptorLimit],
+	domain string,
+	descriptor *ratelimitv3.RateLimitDescriptor,
+	hits uint32,
+) (*envoyrlsv3.RateLimitResponse_DescriptorStatus, error) {
+	limit, ok := lookup(limits, descriptor)
+	if !ok {
+		return &envoyrlsv3.RateLimitResponse_DescriptorStatus{
+			Code: envoyrlsv3.RateLimitResponse_OK,
+		}, nil
+	}
+
+	// Fixed window counters: the key includes the start of the current window
+	window := limit.window()
+	now := s.now()
+	windowStart := now.Truncate(window)
+	untilReset := windowStart.Add(window).Sub(now)
+
+	count, err := s.store.Increment(ctx, counterKey(domain, descriptor, windowStart), hits, untilReset)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptorStatus := &envoyrlsv3.RateLimitResponse_DescriptorStatus{
+		Code: envoyrlsv3.RateLimitResponse_OK,
+		CurrentLimit: &envoyrlsv3.RateLimitResponse_RateLimit{
+			Name:            limit.ID,
+			RequestsPerUnit: limit.Requests,
+			Unit:            toEnvoyUnit(limit.Unit),
+		},
+		DurationUntilReset: durationpb.New(untilReset),
+	}
+	if count > uint64(limit.Requests) {
+		descriptorStatus.Code = envoyrlsv3.RateLimitResponse_OVER_LIMIT
+	} else {
+		descriptorStatus.LimitRemaining = limit.Requests - uint32(count) // nolint:gosec // G115: count is at most limit.Requests
+	}
+	return descriptorStatus, nil
+}
+
+// lookup returns the limit of the TrafficPolicy descriptor identified by the first entry of the descriptor.
+func lookup(limits krt.Collection[DescriptorLimit], descriptor *ratelimitv3.RateLimitDescriptor) (DescriptorLimit, bool) {
+	entries := descriptor.GetEntries()
+	if len(entries) == 0 || entries[0].GetKey() != DescriptorIDKey {
+		return DescriptorLimit{}, false
+	}
+	limit := limits.GetKey(entries[0].GetValue())
+	if limit == nil {
+		return DescriptorLimit{}, false
+	}
+	return *limit, true
+}
+
+func counterKey(domain string, descriptor *ratelimitv3.RateLimitDescriptor, windowStart time.Time) string {
+	var b strings.Builder
+	b.WriteString("kgateway_ratelimit|")
+	b.WriteString(domain)
+	for _, entry := range descriptor.GetEntries() {
+		b.WriteString("|")
+		b.WriteString(entry.GetKey())
+		b.WriteString("=")
+		b.WriteString(entry.GetValue())
+	}
+	b.WriteString("|")
+	b.WriteString(windowStart.UTC().Format(time.RFC3339))
+	return b.String()
+}
+
+func toEnvoyUnit(unit kgateway.RateLimitUnit) envoyrlsv3.RateLimitResponse_RateLimit_Unit {
+	switch unit {
+	case kgateway.RateLimitUnitSecond:
+		return envoyrlsv3.RateLimitResponse_RateLimit_SECOND
+	case kgateway.RateLimitUnitMinute:
+		return envoyrlsv3.RateLimitResponse_RateLimit_MINUTE
+	case kgateway.RateLimitUnitHour:
+		return envoyrlsv3.RateLimitResponse_RateLimit_HOUR
+	case kgateway.RateLimitUnitDay:
+		return envoyrlsv3.RateLimitResponse_RateLimit_DAY
+	default:
+		return envoyrlsv3.RateLimitResponse_RateLimit_UNKNOWN
+	}
+}
+
+// NewStore returns the Redis store if a Redis URL is set, and the memory store otherwise.
+func NewStore(redisURL string) (Store, error) {
+	if redisURL == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewRedisStore(redisURL)
+}
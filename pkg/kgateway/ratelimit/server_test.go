@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoyrlsv3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestDescriptorLimitsCollection(t *testing.T) {
+	limits := newTestLimits(t)
+	assert.ElementsMatch(t, []DescriptorLimit{
+		{ID: "default/policy/0", Requests: 2, Unit: kgateway.RateLimitUnitMinute},
+		{ID: "default/policy/2", Requests: 100, Unit: kgateway.RateLimitUnitDay},
+	}, limits.List())
+}
+
+func TestConnectionLimitsCollection(t *testing.T) {
+	perSource := &kgateway.RateLimitDescriptorLimit{Requests: 10, Unit: kgateway.RateLimitUnitSecond}
+	mock := krttest.NewMock(t, []any{
+		&kgateway.ListenerPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: kgateway.ListenerPolicySpec{
+				Default: &kgateway.ListenerConfig{
+					Connections: &kgateway.ConnectionLimits{PerSourceRateLimit: perSource},
+				},
+				PerPort: []kgateway.ListenerPortConfig{
+					{Port: 5432, Listener: kgateway.ListenerConfig{Connections: &kgateway.ConnectionLimits{PerSourceRateLimit: perSource}}},
+					{Port: 8080, Listener: kgateway.ListenerConfig{Connections: &kgateway.ConnectionLimits{MaxConnections: ptr.To[int32](5)}}},
+				},
+			},
+		},
+	})
+	limits := NewConnectionLimitsCollection(krttest.GetMockCollection[*kgateway.ListenerPolicy](mock))
+	limits.WaitUntilSynced(nil)
+	assert.ElementsMatch(t, []DescriptorLimit{
+		{ID: "ListenerPolicy/default/db/0", Requests: 10, Unit: kgateway.RateLimitUnitSecond},
+		{ID: "ListenerPolicy/default/db/5432", Requests: 10, Unit: kgateway.RateLimitUnitSecond},
+	}, limits.List())
+}
+
+func TestShouldRateLimit(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 15, 0, time.UTC)
+	server := NewServer(newMemoryStore(func() time.Time { return now }))
+	server.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	request := func(entries ...*ratelimitv3.RateLimitDescriptor_Entry) *envoyrlsv3.RateLimitRequest {
+		return &envoyrlsv3.RateLimitRequest{
+			Domain:      "api",
+			Descriptors: []*ratelimitv3.RateLimitDescriptor{{Entries: entries}},
+		}
+	}
+	limited := request(
+		&ratelimitv3.RateLimitDescriptor_Entry{Key: DescriptorIDKey, Value: "default/policy/0"},
+		&ratelimitv3.RateLimitDescriptor_Entry{Key: "remote_address", Value: "10.0.0.1"},
+	)
+
+	// Requests fail until the limits are set and synced, so that they aren't allowed without being counted
+	_, err := server.ShouldRateLimit(ctx, limited)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	server.SetLimits(krt.NewStaticCollection[DescriptorLimit](notSynced{}, nil))
+	_, err = server.ShouldRateLimit(ctx, limited)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	server.SetLimits(newTestLimits(t))
+	for i := range 2 {
+		resp, err := server.ShouldRateLimit(ctx, limited)
+		require.NoError(t, err)
+		assert.Equal(t, envoyrlsv3.RateLimitResponse_OK, resp.GetOverallCode())
+		require.Len(t, resp.GetStatuses(), 1)
+		assert.Equal(t, uint32(1-i), resp.GetStatuses()[0].GetLimitRemaining()) // nolint:gosec // G115: i is at most 1
+		assert.Equal(t, uint32(2), resp.GetStatuses()[0].GetCurrentLimit().GetRequestsPerUnit())
+		assert.Equal(t, envoyrlsv3.RateLimitResponse_RateLimit_MINUTE, resp.GetStatuses()[0].GetCurrentLimit().GetUnit())
+		assert.Equal(t, 45*time.Second, resp.GetStatuses()[0].GetDurationUntilReset().AsDuration())
+	}
+
+	resp, err := server.ShouldRateLimit(ctx, limited)
+	require.NoError(t, err)
+	assert.Equal(t, envoyrlsv3.RateLimitResponse_OVER_LIMIT, resp.GetOverallCode())
+
+	// Counters are per descriptor value
+	resp, err = server.ShouldRateLimit(ctx, request(
+		&ratelimitv3.RateLimitDescriptor_Entry{Key: DescriptorIDKey, Value: "default/policy/0"},
+		&ratelimitv3.RateLimitDescriptor_Entry{Key: "remote_address", Value: "10.0.0.2"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, envoyrlsv3.RateLimitResponse_OK, resp.GetOverallCode())
+
+	// Descriptors without a limit are allowed
+	resp, err = server.ShouldRateLimit(ctx, request(
+		&ratelimitv3.RateLimitDescriptor_Entry{Key: "remote_address", Value: "10.0.0.1"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, envoyrlsv3.RateLimitResponse_OK, resp.GetOverallCode())
+	assert.Nil(t, resp.GetStatuses()[0].GetCurrentLimit())
+
+	// Counters restart in the next window
+	now = now.Add(time.Minute)
+	resp, err = server.ShouldRateLimit(ctx, limited)
+	require.NoError(t, err)
+	assert.Equal(t, envoyrlsv3.RateLimitResponse_OK, resp.GetOverallCode())
+}
+
+func newTestLimits(t *testing.T) krt.Collection[DescriptorLimit] {
+	mock := krttest.NewMock(t, []any{
+		&kgateway.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+			Spec: kgateway.TrafficPolicySpec{
+				RateLimit: &kgateway.RateLimit{
+					Global: &kgateway.RateLimitPolicy{
+						Descriptors: []kgateway.RateLimitDescriptor{
+							{Limit: &kgateway.RateLimitDescriptorLimit{Requests: 2, Unit: kgateway.RateLimitUnitMinute}},
+							{},
+							{Limit: &kgateway.RateLimitDescriptorLimit{Requests: 100, Unit: kgateway.RateLimitUnitDay}},
+						},
+					},
+				},
+			},
+		},
+	})
+	limits := NewDescriptorLimitsCollection(krttest.GetMockCollection[*kgateway.TrafficPolicy](mock))
+	limits.WaitUntilSynced(nil)
+	return limits
+}
+
+// notSynced is the syncer of a collection that never syncs.
+type notSynced struct{}
+
+func (notSynced) HasSynced() bool { return false }
+
+func (notSynced) WaitUntilSynced(<-chan struct{}) bool { return false }
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store keeps the request counters of the rate limit service.
+type Store interface {
+	// Increment adds hits to the counter of the key and returns the new value of the counter.
+	// The counter expires after the given duration.
+	Increment(ctx context.Context, key string, hits uint32, expiration time.Duration) (uint64, error)
+}
+
+type memoryCounter struct {
+	value     uint64
+	expiresAt time.Time
+}
+
+// memoryStore is a Store keeping the counters in memory. The counters are local to the process.
+type memoryStore struct {
+	mu       sync.Mutex
+	counters map[string]*memoryCounter
+	now      func() time.Time
+	// nextSweep is the time after which expired counters are removed
+	nextSweep time.Time
+}
+
+var _ Store = &memoryStore{}
+
+// sweepInterval is the minimum interval between two removals of the expired counters
+const sweepInterval = 10 * time.Second
+
+// NewMemoryStore returns a Store keeping the counters in memory.
+func NewMemoryStore() Store {
+	return newMemoryStore(time.Now)
+}
+
+func newMemoryStore(now func() time.Time) *memoryStore {
+	return &memoryStore{
+		counters: make(map[string]*memoryCounter),
+		now:      now,
+	}
+}
+
+func (s *memoryStore) Increment(_ context.Context, key string, hits uint32, expiration time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.After(s.nextSweep) {
+		for k, c := range s.counters {
+			if !now.Before(c.expiresAt) {
+				delete(s.counters, k)
+			}
+		}
+		s.nextSweep = now.Add(sweepInterval)
+	}
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.expiresAt) {
+		c = &memoryCounter{expiresAt: now.Add(expiration)}
+		s.counters[key] = c
+	}
+	c.value += uint64(hits)
+	return c.value, nil
+}
@@ -97,6 +97,7 @@ func NewControlPlane(
 	authenticators []security.Authenticator,
 	xdsAuth bool,
 	certWatcher *certwatcher.CertWatcher,
+	services ...func(*grpc.Server),
 ) envoycache.SnapshotCache {
 	baseLogger := slog.Default().With("component", "envoy-controlplane")
 	envoyLoggerAdapter := &slogAdapterForEnvoy{logger: baseLogger}
@@ -121,6 +122,11 @@ func NewControlPlane(
 	envoy_service_listener_v3.RegisterListenerDiscoveryServiceServer(kgwGRPCServer, xdsServer)
 	envoy_service_discovery_v3.RegisterAggregatedDiscoveryServiceServer(kgwGRPCServer, xdsServer)
 
+	// Register additional services served to Envoy, e.g. the built-in rate limit service
+	for _, register := range services {
+		register(kgwGRPCServer)
+	}
+
 	// Start both servers on their respective listeners
 	go kgwGRPCServer.Serve(lis)
 
@@ -179,25 +185,27 @@ func getGRPCServerOpts(
 				grpc_zap.StreamServerInterceptor(zap.NewNop()),
 				func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 					slog.Debug("gRPC call", "method", info.FullMethod)
-					if xdsAuth {
-						xdsAuthRequestTotal.Inc()
-						am := authenticationManager{
-							Authenticators: authenticators,
-						}
-						if u := am.authenticate(ss.Context()); u != nil {
-							xdsAuthSuccessTotal.Inc()
-							return handler(srv, &grpc_middleware.WrappedServerStream{
-								ServerStream:   ss,
-								WrappedContext: context.WithValue(ss.Context(), xds.PeerCtxKey, u),
-							})
-						}
-						xdsAuthFailureTotal.Inc()
-						slog.Error("authentication failed", "reasons", am.authFailMsgs)
-						return fmt.Errorf("authentication failed: %v", am.authFailMsgs)
-					} else {
-						slog.Warn("xDS authentication is disabled")
-						return handler(srv, ss)
+					ctx, err := authenticateGRPCCall(ss.Context(), authenticators, xdsAuth)
+					if err != nil {
+						return err
+					}
+					return handler(srv, &grpc_middleware.WrappedServerStream{
+						ServerStream:   ss,
+						WrappedContext: ctx,
+					})
+				},
+			)),
+		// Unary services served alongside xDS, e.g. the built-in rate limit service, are authenticated like the xDS streams
+		grpc.UnaryInterceptor(
+			grpc_middleware.ChainUnaryServer(
+				grpc_zap.UnaryServerInterceptor(zap.NewNop()),
+				func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+					slog.Debug("gRPC call", "method", info.FullMethod)
+					ctx, err := authenticateGRPCCall(ctx, authenticators, xdsAuth)
+					if err != nil {
+						return nil, err
 					}
+					return handler(ctx, req)
 				},
 			)),
 	}
@@ -217,3 +225,23 @@ func getGRPCServerOpts(
 
 	return opts
 }
+
+// authenticateGRPCCall authenticates the caller of a gRPC call if xDS authentication is enabled,
+// and returns the context of the call with the authenticated caller.
+func authenticateGRPCCall(ctx context.Context, authenticators []security.Authenticator, xdsAuth bool) (context.Context, error) {
+	if !xdsAuth {
+		slog.Warn("xDS authentication is disabled")
+		return ctx, nil
+	}
+	xdsAuthRequestTotal.Inc()
+	am := authenticationManager{
+		Authenticators: authenticators,
+	}
+	if u := am.authenticate(ctx); u != nil {
+		xdsAuthSuccessTotal.Inc()
+		return context.WithValue(ctx, xds.PeerCtxKey, u), nil
+	}
+	xdsAuthFailureTotal.Inc()
+	slog.Error("authentication failed", "reasons", am.authFailMsgs)
+	return nil, fmt.Errorf("authentication failed: %v", am.authFailMsgs)
+}
@@ -10,6 +10,8 @@ import (
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/kubetypes"
 	"istio.io/istio/pkg/security"
@@ -24,6 +26,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/jwks"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/jwks_url"
 	agentjwksstore "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/jwksstore"
@@ -35,6 +38,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/controller"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/ratelimit"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
@@ -394,8 +398,18 @@ func (s *setup) Start(ctx context.Context) error {
 
 	// Only create Envoy control plane if Envoy controller is enabled
 	var cache envoycache.SnapshotCache
+	var rateLimitServer *ratelimit.Server
 	if s.globalSettings.EnableEnvoy {
-		cache = NewControlPlane(ctx, s.xdsListener, uniqueClientCallbacks, authenticators, s.globalSettings.XdsAuth, certWatcher)
+		var services []func(*grpc.Server)
+		if s.globalSettings.EnableBuiltinRateLimit {
+			store, err := ratelimit.NewStore(s.globalSettings.BuiltinRateLimitRedisUrl)
+			if err != nil {
+				return fmt.Errorf("error creating built-in rate limit store: %w", err)
+			}
+			rateLimitServer = ratelimit.NewServer(store)
+			services = append(services, rateLimitServer.Register)
+		}
+		cache = NewControlPlane(ctx, s.xdsListener, uniqueClientCallbacks, authenticators, s.globalSettings.XdsAuth, certWatcher, services...)
 	}
 
 	setupOpts := &controller.SetupOpts{
@@ -422,6 +436,23 @@ func (s *setup) Start(ctx context.Context) error {
 		return err
 	}
 
+	if rateLimitServer != nil {
+		trafficPolicies := krt.WrapClient(kclient.NewFilteredDelayed[*kgateway.TrafficPolicy](
+			commoncol.Client,
+			wellknown.TrafficPolicyGVR,
+			kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+		), commoncol.KrtOpts.ToOptions("BuiltinRateLimit/TrafficPolicies")...)
+		listenerPolicies := krt.WrapClient(kclient.NewFilteredDelayed[*kgateway.ListenerPolicy](
+			commoncol.Client,
+			wellknown.ListenerPolicyGVR,
+			kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+		), commoncol.KrtOpts.ToOptions("BuiltinRateLimit/ListenerPolicies")...)
+		rateLimitServer.SetLimits(krt.JoinCollection([]krt.Collection[ratelimit.DescriptorLimit]{
+			ratelimit.NewDescriptorLimitsCollection(trafficPolicies, commoncol.KrtOpts.ToOptions("BuiltinRateLimit/DescriptorLimits")...),
+			ratelimit.NewConnectionLimitsCollection(listenerPolicies, commoncol.KrtOpts.ToOptions("BuiltinRateLimit/ConnectionLimits")...),
+		}, commoncol.KrtOpts.ToOptions("BuiltinRateLimit/Limits")...))
+	}
+
 	var agwCollections *agwplugins.AgwCollections
 	// Only initialize agentgateway collections if agentgateway is enabled
 	if s.globalSettings.EnableAgentgateway {
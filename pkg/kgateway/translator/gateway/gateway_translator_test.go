@@ -583,6 +583,61 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy Wasm different attachment points", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/wasm.yaml",
+			outputFile: "traffic-policy/wasm.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy Cache different attachment points", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/cache.yaml",
+			outputFile: "traffic-policy/cache.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy BandwidthLimit different attachment points", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/bandwidth-limit.yaml",
+			outputFile: "traffic-policy/bandwidth-limit.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy ErrorPages different attachment points", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/error-pages.yaml",
+			outputFile: "traffic-policy/error-pages.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy AdaptiveConcurrency and AdmissionControl different attachment points", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/load-shedding.yaml",
+			outputFile: "traffic-policy/load-shedding.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy ExtProc Full Config", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/extproc-full-config.yaml",
@@ -669,6 +724,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with fault injection attached to route", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/fault-injection-route.yaml",
+			outputFile: "traffic-policy/fault-injection-route.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with header modifiers attached to gateway", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/header-modifiers-gateway.yaml",
@@ -777,6 +843,50 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tcp gateway with network authorization and connection limits", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/network-authorization.yaml",
+			outputFile: "tcp-routing/network-authorization.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("tcp gateway with redis proxy", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/redis-proxy.yaml",
+			outputFile: "tcp-routing/redis-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udp gateway with a single backend", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udp gateway with weighted backends and session settings", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/weighted-session.yaml",
+			outputFile: "udp-routing/weighted-session.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("tls gateway with tcproute", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "tcp-routing/tls.yaml",
@@ -843,6 +953,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tls gateway terminating tls for tlsroutes", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tls-routing/terminate.yaml",
+			outputFile: "tls-routing/terminate.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("grpc gateway with basic routing", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "grpc-routing/basic.yaml",
@@ -909,6 +1030,72 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("AWS service backend", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/aws_service.yaml",
+			outputFile: "backends/aws_service.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("AWS backends with web identity, pod identity and instance metadata auth", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/aws_auth.yaml",
+			outputFile: "backends/aws_auth.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("Static backend with host weights, priorities, localities and metadata", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/static_host_weights.yaml",
+			outputFile: "backends/static_host_weights.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("DnsSrv backend and dns config", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/dns_srv.yaml",
+			outputFile: "backends/dns_srv.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("Failover backend", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/failover.yaml",
+			outputFile: "backends/failover.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("Failover backend without resolvable backendRefs", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/failover-unresolved.yaml",
+			outputFile: "backends/failover-unresolved.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DFP Backend with TLS", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "dfp/tls.yaml",
@@ -920,6 +1107,39 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("DFP Backend with allowed hosts and DNS cache", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "dfp/hosts.yaml",
+			outputFile: "dfp/hosts.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("DFP Backend with allowed hosts rejects host rewrites and request mirrors", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "dfp/hosts-bypass.yaml",
+			outputFile: "dfp/hosts-bypass.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("DFP Backend with allowed hosts checks the host set by a transformation", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "dfp/hosts-transformation.yaml",
+			outputFile: "dfp/hosts-transformation.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DFP Backend with simple", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "dfp/simple.yaml",
@@ -1206,6 +1426,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("ListenerPolicy with geoIP", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy-http/geoip.yaml",
+			outputFile: "listener-policy-http/geoip.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("ListenerPolicy with idleTimeout", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "listener-policy-http/idle-timeout.yaml",
@@ -1415,6 +1646,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("Backend Config Policy with PROXY protocol and HTTP CONNECT proxy", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backendconfigpolicy/upstream-transport.yaml",
+			outputFile: "backendconfigpolicy/upstream-transport.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("Backend Config Policy with OutlierDetection", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "backendconfigpolicy/outlierdetection.yaml",
@@ -1659,6 +1901,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy local RateLimit with descriptors", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/local-rate-limit-descriptors.yaml",
+			outputFile: "traffic-policy/local-rate-limit-descriptors.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy local RateLimit with share key", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/local-rate-limit-share-key.yaml",
+			outputFile: "traffic-policy/local-rate-limit-share-key.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		})
+	})
+
 	t.Run("TLS listener with no routes", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "invalid-filter-chains/tls-listener-no-routes.yaml",
@@ -1901,6 +2165,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("ListenerPolicy with HTTP/3", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy/http3.yaml",
+			outputFile: "listener-policy/http3.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("ListenerPolicy with client certificate validation", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy/mtls.yaml",
+			outputFile: "listener-policy/mtls.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("ListenerPolicy with per port settings", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "listener-policy/per-port.yaml",
@@ -1,14 +1,18 @@
 package irtranslator
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_tls_inspector "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoynetworkrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
@@ -237,9 +241,24 @@ func (h *hcmNetworkFilterTranslator) computeNetworkFilters(l ir.HttpFilterChainI
 		reportPolicyAttachmentStatus(h.reporter, h.policyAncestorRef, mergeOrigins, pols...)
 	}
 
+	// 4. Allow all plugins to finalize the HCM with the state collected while translating the routes
+	hCtx := ir.HttpFiltersContext{
+		ListenerPort: h.lis.BindPort,
+	}
+	for _, plug := range h.pluginPass {
+		if err := plug.FinalizeHCM(hCtx, l.FilterChainCommon, httpConnectionManager); err != nil {
+			h.listenerReporter.SetCondition(sdkreporter.ListenerCondition{
+				Type:    gwv1.ListenerConditionProgrammed,
+				Reason:  gwv1.ListenerReasonInvalid,
+				Status:  metav1.ConditionFalse,
+				Message: "Error processing HCM plugin: " + err.Error(),
+			})
+		}
+	}
+
 	// TODO: should we enable websockets by default?
 
-	// 4. Generate the typedConfig for the HCM
+	// 5. Generate the typedConfig for the HCM
 	hcmFilter, err := NewFilterWithTypedConfig(wellknown.HTTPConnectionManager, httpConnectionManager)
 	if err != nil {
 		logger.Error("failed to convert proto message to any", "error", err)
@@ -375,7 +394,13 @@ func sortHttpFilters(filters filters.StagedHttpFilterList) []*envoyhttp.HttpFilt
 }
 
 func (h *filterChainTranslator) computeTcpFilters(l ir.TcpIR, reporter sdkreporter.ListenerReporter) []*envoylistenerv3.Filter {
-	networkFilters := sortNetworkFilters(h.computeCustomFilters(l.CustomNetworkFilters, reporter))
+	stagedFilters := h.computeCustomFilters(l.CustomNetworkFilters, reporter)
+	policyFilters, terminalFilter := h.runTcpRoutePolicies(l)
+	stagedFilters = append(stagedFilters, policyFilters...)
+	networkFilters := sortNetworkFilters(stagedFilters)
+	if terminalFilter != nil {
+		return append(networkFilters, terminalFilter)
+	}
 
 	cfg := &envoytcp.TcpProxy{
 		StatPrefix: l.FilterChainName,
@@ -406,6 +431,79 @@ func (h *filterChainTranslator) computeTcpFilters(l ir.TcpIR, reporter sdkreport
 	return append(networkFilters, tcpFilter)
 }
 
+// runTcpRoutePolicies returns the network filters of the policies attached to the TCPRoute or TLSRoute
+// of the filter chain, and the filter replacing the tcp_proxy filter if a policy sets one.
+// If a policy fails with an ir.DenyConnectionsError, e.g. an authorization policy, all connections are
+// denied rather than forwarded without the policy, similar to routes being replaced with a direct response
+// for HTTP. Policies failing with other errors are reported and the connections are still forwarded.
+func (h *filterChainTranslator) runTcpRoutePolicies(l ir.TcpIR) ([]filters.StagedNetworkFilter, *envoylistenerv3.Filter) {
+	var networkFilters []filters.StagedNetworkFilter
+	var terminalFilter *envoylistenerv3.Filter
+	var denyErrs []error
+	for _, gk := range l.AttachedPolicies.ApplyOrderedGroupKinds() {
+		pols := l.AttachedPolicies.Policies[gk]
+		pass := h.pluginPass[gk]
+		if pass == nil {
+			// TODO: should never happen, log error and report condition
+			continue
+		}
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		policies, mergeOrigins := mergePolicies(pass, pols)
+		var applyErrs []error
+		for _, pol := range policies {
+			if i := slices.IndexFunc(pol.Errors, ir.IsDenyConnectionsError); i != -1 {
+				denyErrs = append(denyErrs, pol.Errors[i])
+				continue
+			}
+			pCtx := &ir.TcpRouteContext{
+				FilterChainName:   l.FilterChainName,
+				Policy:            pol.PolicyIr,
+				ListenerPort:      h.listener.BindPort,
+				PolicyAncestorRef: h.listener.PolicyAncestorRef,
+				BackendRefs:       l.BackendRefs,
+			}
+			stagedFilters, err := pass.ApplyForTcpRoute(pCtx)
+			if err != nil {
+				applyErrs = append(applyErrs, err)
+				if ir.IsDenyConnectionsError(err) {
+					denyErrs = append(denyErrs, err)
+				}
+				continue
+			}
+			networkFilters = append(networkFilters, stagedFilters...)
+			if pCtx.TerminalFilter != nil {
+				terminalFilter = pCtx.TerminalFilter
+			}
+		}
+		if len(applyErrs) > 0 {
+			logger.Error("failed to apply tcp route policies", "filter_chain", l.FilterChainName, "error", errors.Join(applyErrs...))
+			reportPolicyErrorStatus(h.reporter, h.listener.PolicyAncestorRef, errors.Join(applyErrs...), pols...)
+			continue
+		}
+		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pols...)
+	}
+
+	if len(denyErrs) > 0 {
+		logger.Error("denying connections to tcp filter chain with invalid policies", "filter_chain", l.FilterChainName, "error", errors.Join(denyErrs...))
+		return []filters.StagedNetworkFilter{{
+			Filter: denyAllNetworkFilter(),
+			Stage:  filters.DuringStage(filters.AuthZStage),
+		}}, nil
+	}
+	return networkFilters, terminalFilter
+}
+
+// denyAllNetworkFilter returns a network RBAC filter that closes all connections.
+func denyAllNetworkFilter() *envoylistenerv3.Filter {
+	filter, _ := NewFilterWithTypedConfig(wellknown.RoleBasedAccessControl, &envoynetworkrbac.RBAC{
+		StatPrefix: "deny_all",
+		Rules: &envoyrbacv3.RBAC{
+			Action: envoyrbacv3.RBAC_ALLOW,
+		},
+	})
+	return filter
+}
+
 func NewFilterWithTypedConfig(name string, config proto.Message) (*envoylistenerv3.Filter, error) {
 	s := &envoylistenerv3.Filter{
 		Name: name,
@@ -2,12 +2,18 @@ package irtranslator_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -109,3 +115,116 @@ func TestFilterChains(t *testing.T) {
 		}
 	}
 }
+
+var tcpPoliciesGK = schema.GroupKind{
+	Group: "test.kgateway.dev",
+	Kind:  "TcpPolicyForTest",
+}
+
+type tcpPolicy struct {
+	applyErr error
+}
+
+func (p tcpPolicy) CreationTime() time.Time { return time.Time{} }
+func (p tcpPolicy) Equals(in any) bool      { return false }
+
+// tcpPolicies implements a test translation pass that adds a network filter for each TCP route policy
+type tcpPolicies struct {
+	ir.UnimplementedProxyTranslationPass
+}
+
+func (tcpPolicies) ApplyForTcpRoute(pCtx *ir.TcpRouteContext) ([]filters.StagedNetworkFilter, error) {
+	if err := pCtx.Policy.(tcpPolicy).applyErr; err != nil {
+		return nil, err
+	}
+	return []filters.StagedNetworkFilter{{
+		Filter: &envoylistenerv3.Filter{Name: testPluginFilterName},
+		Stage:  filters.DuringStage(filters.AuthZStage),
+	}}, nil
+}
+
+func TestTcpRoutePolicyErrors(t *testing.T) {
+	policyRef := &ir.AttachedPolicyRef{Group: tcpPoliciesGK.Group, Kind: tcpPoliciesGK.Kind, Namespace: "default", Name: "policy"}
+	policyKey := reporter.PolicyKey{Group: tcpPoliciesGK.Group, Kind: tcpPoliciesGK.Kind, Namespace: "default", Name: "policy"}
+
+	tests := []struct {
+		name         string
+		policy       ir.PolicyAtt
+		wantFilters  []string
+		wantAccepted metav1.ConditionStatus
+	}{
+		{
+			name:         "policy is applied",
+			policy:       ir.PolicyAtt{PolicyIr: tcpPolicy{}, PolicyRef: policyRef},
+			wantFilters:  []string{testPluginFilterName, wellknown.TCPProxy},
+			wantAccepted: metav1.ConditionTrue,
+		},
+		{
+			name: "policy with unrelated errors is still applied",
+			policy: ir.PolicyAtt{
+				PolicyIr:  tcpPolicy{},
+				PolicyRef: policyRef,
+				Errors:    []error{errors.New("http setting is invalid")},
+			},
+			wantFilters:  []string{testPluginFilterName, wellknown.TCPProxy},
+			wantAccepted: metav1.ConditionFalse,
+		},
+		{
+			name: "policy with deny connections error denies all connections",
+			policy: ir.PolicyAtt{
+				PolicyIr:  tcpPolicy{},
+				PolicyRef: policyRef,
+				Errors:    []error{ir.DenyConnectionsError{Err: errors.New("authorization is invalid")}},
+			},
+			wantFilters:  []string{wellknown.RoleBasedAccessControl, wellknown.TCPProxy},
+			wantAccepted: metav1.ConditionFalse,
+		},
+		{
+			name:         "policy failing to apply is reported and connections are forwarded",
+			policy:       ir.PolicyAtt{PolicyIr: tcpPolicy{applyErr: errors.New("failed")}, PolicyRef: policyRef},
+			wantFilters:  []string{wellknown.TCPProxy},
+			wantAccepted: metav1.ConditionFalse,
+		},
+		{
+			name:         "policy failing to apply with deny connections error denies all connections",
+			policy:       ir.PolicyAtt{PolicyIr: tcpPolicy{applyErr: ir.DenyConnectionsError{Err: errors.New("failed")}}, PolicyRef: policyRef},
+			wantFilters:  []string{wellknown.RoleBasedAccessControl, wellknown.TCPProxy},
+			wantAccepted: metav1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportMap := reports.NewReportMap()
+			listener := ir.ListenerIR{
+				TcpFilterChain: []ir.TcpIR{{
+					FilterChainCommon: ir.FilterChainCommon{FilterChainName: "tcpchain"},
+					BackendRefs:       []ir.BackendRefIR{{ClusterName: "backend"}},
+					AttachedPolicies: ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+						tcpPoliciesGK: {tt.policy},
+					}},
+				}},
+			}
+
+			envoyListener, _ := (&irtranslator.Translator{}).ComputeListener(
+				context.Background(),
+				irtranslator.TranslationPassPlugins{
+					tcpPoliciesGK: &irtranslator.TranslationPass{ProxyTranslationPass: tcpPolicies{}},
+				},
+				ir.GatewayIR{SourceObject: &ir.Gateway{Obj: &gwv1.Gateway{}}},
+				listener,
+				reports.NewReporter(&reportMap),
+			)
+
+			require.Len(t, envoyListener.GetFilterChains(), 1)
+			assert.Equal(t, tt.wantFilters, slices.Map(envoyListener.GetFilterChains()[0].GetFilters(), (*envoylistenerv3.Filter).GetName))
+
+			require.Contains(t, reportMap.Policies, policyKey)
+			require.NotEmpty(t, reportMap.Policies[policyKey].Ancestors)
+			for _, ancestor := range reportMap.Policies[policyKey].Ancestors {
+				accepted := meta.FindStatusCondition(ancestor.Conditions, "Accepted")
+				require.NotNil(t, accepted)
+				assert.Equal(t, tt.wantAccepted, accepted.Status)
+			}
+		})
+	}
+}
@@ -10,7 +10,9 @@ import (
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -48,6 +50,12 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 	var res TranslationResult
 
 	for _, l := range gw.Listeners {
+		if l.UdpProxy != nil {
+			if outListener := t.computeUdpListener(pass, gw, l, reporter); outListener != nil {
+				res.Listeners = append(res.Listeners, outListener)
+			}
+			continue
+		}
 		outListener, routes := t.ComputeListener(ctx, pass, gw, l, reporter)
 		// Envoy rejects listeners with no filter chains; skip adding such listeners.
 		if outListener == nil || len(outListener.GetFilterChains()) == 0 {
@@ -56,6 +64,7 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 			continue
 		}
 		res.Listeners = append(res.Listeners, outListener)
+		res.Listeners = append(res.Listeners, t.finalizeListener(pass, gw, l, reporter, outListener)...)
 		res.Routes = append(res.Routes, routes...)
 	}
 
@@ -64,6 +73,7 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 			r := c.ResourcesToAdd()
 			res.ExtraClusters = append(res.ExtraClusters, r.Clusters...)
 			res.Secrets = append(res.Secrets, r.Secrets...)
+			res.Listeners = append(res.Listeners, r.Listeners...)
 		}
 	}
 
@@ -172,6 +182,7 @@ func (t *Translator) ComputeListener(
 	fct := filterChainTranslator{
 		listener:   lis,
 		gateway:    gw,
+		reporter:   reporter,
 		pluginPass: pass,
 	}
 
@@ -232,6 +243,50 @@ func (t *Translator) runListenerPlugins(
 	}
 }
 
+// finalizeListener allows all plugins to tweak the translated filter chains of the listener, and returns
+// the additional listeners derived from it. Plugins are run in a stable order, so that the output is idempotent.
+func (t *Translator) finalizeListener(
+	pass TranslationPassPlugins,
+	gw ir.GatewayIR,
+	l ir.ListenerIR,
+	reporter sdkreporter.Reporter,
+	out *envoylistenerv3.Listener,
+) []*envoylistenerv3.Listener {
+	var derived []*envoylistenerv3.Listener
+	pCtx := &ir.FinalizeListenerContext{
+		Port: l.BindPort,
+	}
+	for _, gk := range slices.SortBy(maps.Keys(pass), schema.GroupKind.String) {
+		listeners, err := pass[gk].FinalizeListener(pCtx, out)
+		if err != nil {
+			logger.Error("failed to finalize listener", "listener", out.GetName(), "plugin", pass[gk].Name, "error", err)
+			reportListenerFilterChainsError(gw, l, reporter, "Error finalizing listener: "+err.Error())
+		}
+		derived = append(derived, listeners...)
+	}
+	return derived
+}
+
+// reportListenerFilterChainsError sets Programmed=False on the Gateway listeners the filter chains
+// of the listener are translated from.
+func reportListenerFilterChainsError(gw ir.GatewayIR, l ir.ListenerIR, reporter sdkreporter.Reporter, message string) {
+	var filterChainNames []string
+	for _, hfc := range l.HttpFilterChain {
+		filterChainNames = append(filterChainNames, hfc.FilterChainName)
+	}
+	for _, tfc := range l.TcpFilterChain {
+		filterChainNames = append(filterChainNames, tfc.FilterChainName)
+	}
+	for _, name := range filterChainNames {
+		getReporterForFilterChain(gw, reporter, name).SetCondition(sdkreporter.ListenerCondition{
+			Type:    gwv1.ListenerConditionProgrammed,
+			Reason:  gwv1.ListenerReasonInvalid,
+			Status:  metav1.ConditionFalse,
+			Message: message,
+		})
+	}
+}
+
 func (t *Translator) newPass(reporter sdkreporter.Reporter) TranslationPassPlugins {
 	ret := TranslationPassPlugins{}
 	for k, v := range t.ContributedPolicies {
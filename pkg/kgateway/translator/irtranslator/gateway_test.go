@@ -0,0 +1,102 @@
+package irtranslator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/slices"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+var finalizeListenerGK = schema.GroupKind{
+	Group: "test.kgateway.dev",
+	Kind:  "FinalizeListenerForTest",
+}
+
+// finalizeListener implements a test translation pass that renames the translated filter chains
+// and derives a listener from the translated listener
+type finalizeListener struct {
+	ir.UnimplementedProxyTranslationPass
+	err error
+}
+
+func (f finalizeListener) FinalizeListener(pCtx *ir.FinalizeListenerContext, out *envoylistenerv3.Listener) ([]*envoylistenerv3.Listener, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, fc := range out.GetFilterChains() {
+		fc.Name += "-finalized"
+	}
+	return []*envoylistenerv3.Listener{{Name: out.GetName() + "-derived"}}, nil
+}
+
+func TestFinalizeListener(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"},
+	}
+	gwIR := ir.GatewayIR{
+		SourceObject: &ir.Gateway{
+			Obj: gw,
+			Listeners: []ir.Listener{{
+				Listener: gwv1.Listener{Name: "tcp", Port: 8080},
+				Parent:   gw,
+			}},
+		},
+		Listeners: []ir.ListenerIR{{
+			Name:     "listener~8080",
+			BindPort: 8080,
+			TcpFilterChain: []ir.TcpIR{{
+				FilterChainCommon: ir.FilterChainCommon{FilterChainName: "tcp"},
+				BackendRefs:       []ir.BackendRefIR{{ClusterName: "backend"}},
+			}},
+		}},
+	}
+
+	translate := func(err error) (irtranslator.TranslationResult, reports.ReportMap) {
+		translator := irtranslator.Translator{
+			ContributedPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
+				finalizeListenerGK: {
+					NewGatewayTranslationPass: func(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
+						return finalizeListener{err: err}
+					},
+				},
+			},
+		}
+		reportMap := reports.NewReportMap()
+		return translator.Translate(context.Background(), gwIR, reports.NewReporter(&reportMap)), reportMap
+	}
+
+	t.Run("filter chains are finalized and derived listeners are added", func(t *testing.T) {
+		res, _ := translate(nil)
+
+		require.Len(t, res.Listeners, 2)
+		assert.Equal(t, "listener~8080", res.Listeners[0].GetName())
+		assert.Equal(t, "listener~8080-derived", res.Listeners[1].GetName())
+		assert.Equal(t, []string{"tcp-finalized"}, slices.Map(res.Listeners[0].GetFilterChains(), (*envoylistenerv3.FilterChain).GetName))
+	})
+
+	t.Run("errors are reported on the listeners", func(t *testing.T) {
+		res, reportMap := translate(errors.New("invalid listener settings"))
+
+		require.Len(t, res.Listeners, 1)
+		listenerReport, ok := reports.NewReporter(&reportMap).Gateway(gw).ListenerName("tcp").(*reports.ListenerReport)
+		require.True(t, ok)
+		programmed := meta.FindStatusCondition(listenerReport.Status.Conditions, string(gwv1.ListenerConditionProgrammed))
+		require.NotNil(t, programmed)
+		assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+		assert.Contains(t, programmed.Message, "invalid listener settings")
+	})
+}
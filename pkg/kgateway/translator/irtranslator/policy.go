@@ -56,6 +56,35 @@ func reportPolicyAcceptanceStatus(
 	}
 }
 
+// reportPolicyErrorStatus reports the policies as not accepted because they failed to be applied.
+func reportPolicyErrorStatus(
+	rp reporter.Reporter,
+	ancestorRef gwv1.ParentReference,
+	err error,
+	policies ...ir.PolicyAtt,
+) {
+	for _, policy := range policies {
+		if policy.PolicyRef == nil {
+			// Not a policy associated with a CR, can't report status on it
+			continue
+		}
+
+		key := reporter.PolicyKey{
+			Group:     policy.PolicyRef.Group,
+			Kind:      policy.PolicyRef.Kind,
+			Namespace: policy.PolicyRef.Namespace,
+			Name:      policy.PolicyRef.Name,
+		}
+		rp.Policy(key, policy.Generation).AncestorRef(ancestorRef).SetCondition(reporter.PolicyCondition{
+			Type:               string(shared.PolicyConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(shared.PolicyReasonInvalid),
+			Message:            err.Error(),
+			ObservedGeneration: policy.Generation,
+		})
+	}
+}
+
 func reportPolicyAttachmentStatus(
 	rp reporter.Reporter,
 	ancestorRef gwv1.ParentReference,
@@ -240,6 +240,9 @@ func (h *httpRouteConfigurationTranslator) envoyRoutes(
 
 	// Run plugins here that may set action. Handle the routeProcessingErr error later.
 	routeProcessingErr := h.runRoutePlugins(in, out, backendConfigCtx.typedPerFilterConfigRoute)
+	if routeProcessingErr == nil {
+		routeProcessingErr = validateHostRewrite(in, out)
+	}
 
 	// Apply typed per filter config from translating route action and route plugins
 	typedPerFilterConfig := backendConfigCtx.typedPerFilterConfigRoute.ToAnyMap()
@@ -417,6 +420,7 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 			In:                in,
 			TypedFilterConfig: typedPerFilterConfig,
 			ListenerPort:      h.listener.BindPort,
+			PolicyAncestorRef: h.listener.PolicyAncestorRef,
 		}
 		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
 		policies, mergeOrigins := mergePolicies(pass, pols)
@@ -491,6 +495,22 @@ func (h *httpRouteConfigurationTranslator) runBackend(in ir.HttpBackend, pCtx *i
 	return errors.Join(errs...)
 }
 
+// validateHostRewrite rejects host rewrites on routes to backends that only forward requests to some hosts,
+// since the hosts are checked on the authority of requests before the host is rewritten.
+func validateHostRewrite(in ir.HttpRouteRuleMatchIR, out *envoyroutev3.Route) error {
+	switch out.GetRoute().GetHostRewriteSpecifier().(type) {
+	case nil, *envoyroutev3.RouteAction_AutoHostRewrite:
+		// the auto host rewrite uses the host of the upstream, which is the authority of the request for these backends
+		return nil
+	}
+	for _, backend := range in.Backends {
+		if b := backend.Backend.BackendObject; ir.BackendRestrictsHosts(b) {
+			return fmt.Errorf("the host can't be rewritten on routes to backend %s/%s, since it restricts the hosts requests are forwarded to", b.Namespace, b.Name)
+		}
+	}
+	return nil
+}
+
 func (h *httpRouteConfigurationTranslator) translateRouteAction(
 	in ir.HttpRouteRuleMatchIR,
 	outRoute *envoyroutev3.Route,
@@ -0,0 +1,202 @@
+package irtranslator
+
+import (
+	"errors"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	envoynetworkinputsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	envoyconsistenthashingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/consistent_hashing/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	sdkreporter "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	udpProxyListenerFilterName = "envoy.filters.udp_listener.udp_proxy"
+	udpRouteActionName         = "route"
+)
+
+// computeUdpListener translates a UDP listener. UDP listeners have no filter chains, the datagrams
+// are forwarded by the udp_proxy listener filter. Listener policies are not applied to UDP listeners.
+// Returns nil if the listener can't be translated.
+func (t *Translator) computeUdpListener(
+	pass TranslationPassPlugins,
+	gw ir.GatewayIR,
+	lis ir.ListenerIR,
+	reporter sdkreporter.Reporter,
+) *envoylistenerv3.Listener {
+	udp := lis.UdpProxy
+	cfg := &envoyudpproxyv3.UdpProxyConfig{
+		StatPrefix: udp.Name,
+	}
+	listenerReporter := getReporterForUdpListener(gw, udp, reporter)
+	if err := runUdpRoutePolicies(pass, lis, reporter, cfg); err != nil {
+		// there is no way to reject datagrams like connections, so the listener is dropped instead
+		logger.Error("skipping udp listener with invalid policies", "listener", lis.Name, "error", err)
+		listenerReporter.SetCondition(sdkreporter.ListenerCondition{
+			Type:    gwv1.ListenerConditionProgrammed,
+			Reason:  gwv1.ListenerReasonInvalid,
+			Status:  metav1.ConditionFalse,
+			Message: "Error applying UDPRoute policies: " + err.Error(),
+		})
+		return nil
+	}
+
+	matcher := udpBackendsMatcher(udp.BackendRefs)
+	if matcher == nil {
+		logger.Warn("skipping udp listener without backends with a weight", "listener", lis.Name)
+		listenerReporter.SetCondition(sdkreporter.ListenerCondition{
+			Type:    gwv1.ListenerConditionProgrammed,
+			Reason:  gwv1.ListenerReasonInvalid,
+			Status:  metav1.ConditionFalse,
+			Message: "UDPRoute has no backends with a weight",
+		})
+		return nil
+	}
+	cfg.RouteSpecifier = &envoyudpproxyv3.UdpProxyConfig_Matcher{
+		Matcher: matcher,
+	}
+
+	address := computeListenerAddress(lis.BindAddress, lis.BindPort, reporter.Gateway(gw.SourceObject.Obj))
+	address.GetSocketAddress().Protocol = envoycorev3.SocketAddress_UDP
+	return &envoylistenerv3.Listener{
+		Name:    lis.Name,
+		Address: address,
+		ListenerFilters: []*envoylistenerv3.ListenerFilter{{
+			Name: udpProxyListenerFilterName,
+			ConfigType: &envoylistenerv3.ListenerFilter_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(cfg),
+			},
+		}},
+	}
+}
+
+func getReporterForUdpListener(gw ir.GatewayIR, udp *ir.UdpIR, reporter sdkreporter.Reporter) sdkreporter.ListenerReporter {
+	if udp.ParentRef.Parent == nil {
+		// This should never happen, but keep this as a safeguard.
+		return reporter.Gateway(gw.SourceObject.Obj).ListenerName(udp.Name)
+	}
+	return udp.ParentRef.GetParentReporter(reporter).ListenerName(string(udp.ParentRef.Name))
+}
+
+// runUdpRoutePolicies applies the policies attached to the UDPRoute of the listener to the UDP proxy.
+func runUdpRoutePolicies(
+	pass TranslationPassPlugins,
+	lis ir.ListenerIR,
+	reporter sdkreporter.Reporter,
+	out *envoyudpproxyv3.UdpProxyConfig,
+) error {
+	attachedPolicies := lis.UdpProxy.AttachedPolicies
+	var errs []error
+	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
+		pols := attachedPolicies.Policies[gk]
+		pass := pass[gk]
+		if pass == nil {
+			// TODO: should never happen, log error and report condition
+			continue
+		}
+		reportPolicyAcceptanceStatus(reporter, lis.PolicyAncestorRef, pols...)
+		policies, mergeOrigins := mergePolicies(pass, pols)
+		for _, pol := range policies {
+			if len(pol.Errors) > 0 {
+				errs = append(errs, pol.Errors...)
+				continue
+			}
+			if err := pass.ApplyForUdpRoute(&ir.UdpRouteContext{
+				Policy:            pol.PolicyIr,
+				ListenerPort:      lis.BindPort,
+				PolicyAncestorRef: lis.PolicyAncestorRef,
+			}, out); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		reportPolicyAttachmentStatus(reporter, lis.PolicyAncestorRef, mergeOrigins, pols...)
+	}
+	return errors.Join(errs...)
+}
+
+// udpBackendsMatcher returns the matcher selecting the cluster of each session. The UDP proxy can't
+// split sessions randomly between clusters, so the sessions are split on a consistent hash of the client
+// IP address instead, each backend matching a share of the hash range proportional to its weight.
+// Returns nil if no backend has a weight.
+func udpBackendsMatcher(backends []ir.BackendRefIR) *xdsmatcherv3.Matcher {
+	var weighted []ir.BackendRefIR
+	var total uint32
+	for _, backend := range backends {
+		if backend.Weight == 0 {
+			continue
+		}
+		weighted = append(weighted, backend)
+		total += backend.Weight
+	}
+	if len(weighted) == 0 {
+		return nil
+	}
+
+	matcher := &xdsmatcherv3.Matcher{
+		OnNoMatch: udpRouteAction(weighted[len(weighted)-1].ClusterName),
+	}
+	if len(weighted) == 1 {
+		return matcher
+	}
+
+	// the backends are matched in order on hash % total >= threshold, so each threshold is lowered
+	// by the weight of the backend, and the last backend gets the remaining range when nothing matches
+	var fieldMatchers []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher
+	threshold := total
+	for _, backend := range weighted[:len(weighted)-1] {
+		threshold -= backend.Weight
+		fieldMatchers = append(fieldMatchers, &xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: sourceIPHashPredicate(threshold, total),
+			OnMatch:   udpRouteAction(backend.ClusterName),
+		})
+	}
+	matcher.MatcherType = &xdsmatcherv3.Matcher_MatcherList_{
+		MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+			Matchers: fieldMatchers,
+		},
+	}
+	return matcher
+}
+
+func sourceIPHashPredicate(threshold, modulo uint32) *xdsmatcherv3.Matcher_MatcherList_Predicate {
+	return &xdsmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &xdscorev3.TypedExtensionConfig{
+					Name:        "envoy.matching.inputs.source_ip",
+					TypedConfig: utils.MustMessageToAny(&envoynetworkinputsv3.SourceIPInput{}),
+				},
+				Matcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: &xdscorev3.TypedExtensionConfig{
+						Name: "envoy.matching.matchers.consistent_hashing",
+						TypedConfig: utils.MustMessageToAny(&envoyconsistenthashingv3.ConsistentHashing{
+							Threshold: threshold,
+							Modulo:    modulo,
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+func udpRouteAction(cluster string) *xdsmatcherv3.Matcher_OnMatch {
+	return &xdsmatcherv3.Matcher_OnMatch{
+		OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+			Action: &xdscorev3.TypedExtensionConfig{
+				Name: udpRouteActionName,
+				TypedConfig: utils.MustMessageToAny(&envoyudpproxyv3.Route{
+					Cluster: cluster,
+				}),
+			},
+		},
+	}
+}
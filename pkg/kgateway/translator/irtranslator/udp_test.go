@@ -0,0 +1,127 @@
+package irtranslator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoyudpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	envoyconsistenthashingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/consistent_hashing/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestUdpBackendsMatcher(t *testing.T) {
+	assert.Nil(t, udpBackendsMatcher([]ir.BackendRefIR{{ClusterName: "a", Weight: 0}}))
+
+	single := udpBackendsMatcher([]ir.BackendRefIR{
+		{ClusterName: "a", Weight: 0},
+		{ClusterName: "b", Weight: 1},
+	})
+	require.NotNil(t, single)
+	assert.Nil(t, single.GetMatcherList())
+	assert.Equal(t, "b", routeCluster(t, single.GetOnNoMatch()))
+
+	weighted := udpBackendsMatcher([]ir.BackendRefIR{
+		{ClusterName: "a", Weight: 20},
+		{ClusterName: "b", Weight: 30},
+		{ClusterName: "c", Weight: 50},
+	})
+	require.NotNil(t, weighted)
+	assert.Equal(t, "c", routeCluster(t, weighted.GetOnNoMatch()))
+	matchers := weighted.GetMatcherList().GetMatchers()
+	require.Len(t, matchers, 2)
+
+	for i, want := range []struct {
+		cluster   string
+		threshold uint32
+	}{
+		{cluster: "a", threshold: 80},
+		{cluster: "b", threshold: 50},
+	} {
+		assert.Equal(t, want.cluster, routeCluster(t, matchers[i].GetOnMatch()))
+		hashing := &envoyconsistenthashingv3.ConsistentHashing{}
+		require.NoError(t, matchers[i].GetPredicate().GetSinglePredicate().GetCustomMatch().GetTypedConfig().UnmarshalTo(hashing))
+		assert.Equal(t, want.threshold, hashing.GetThreshold())
+		assert.Equal(t, uint32(100), hashing.GetModulo())
+	}
+}
+
+func routeCluster(t *testing.T, onMatch *xdsmatcherv3.Matcher_OnMatch) string {
+	t.Helper()
+	route := &envoyudpproxyv3.Route{}
+	require.NoError(t, onMatch.GetAction().GetTypedConfig().UnmarshalTo(route))
+	return route.GetCluster()
+}
+
+var udpPoliciesGK = schema.GroupKind{Group: "test.kgateway.dev", Kind: "UdpPolicyForTest"}
+
+type udpPolicy struct{}
+
+func (p udpPolicy) CreationTime() time.Time { return time.Time{} }
+func (p udpPolicy) Equals(in any) bool      { return false }
+
+type udpPolicies struct {
+	ir.UnimplementedProxyTranslationPass
+}
+
+func TestComputeUdpListenerReportsDroppedListeners(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"}}
+	gwListener := ir.Listener{Listener: gwv1.Listener{Name: "udp", Port: 5353}, Parent: gw}
+	gwIR := ir.GatewayIR{SourceObject: &ir.Gateway{Obj: gw, Listeners: []ir.Listener{gwListener}}}
+	pass := TranslationPassPlugins{
+		udpPoliciesGK: &TranslationPass{ProxyTranslationPass: udpPolicies{}},
+	}
+
+	tests := []struct {
+		name        string
+		udp         ir.UdpIR
+		wantMessage string
+	}{
+		{
+			name: "invalid policies",
+			udp: ir.UdpIR{
+				Name:        "udp-default.route-rule-0",
+				BackendRefs: []ir.BackendRefIR{{ClusterName: "backend", Weight: 1}},
+				AttachedPolicies: ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+					udpPoliciesGK: {{PolicyIr: udpPolicy{}, Errors: []error{errors.New("invalid session settings")}}},
+				}},
+				ParentRef: gwListener,
+			},
+			wantMessage: "invalid session settings",
+		},
+		{
+			name: "no backends with a weight",
+			udp: ir.UdpIR{
+				Name:        "udp-default.route-rule-0",
+				BackendRefs: []ir.BackendRefIR{{ClusterName: "backend", Weight: 0}},
+				ParentRef:   gwListener,
+			},
+			wantMessage: "no backends with a weight",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportMap := reports.NewReportMap()
+			rp := reports.NewReporter(&reportMap)
+			udp := tt.udp
+			out := (&Translator{}).computeUdpListener(pass, gwIR, ir.ListenerIR{Name: "listener~5353", BindPort: 5353, UdpProxy: &udp}, rp)
+			assert.Nil(t, out)
+
+			listenerReport, ok := rp.Gateway(gw).ListenerName("udp").(*reports.ListenerReport)
+			require.True(t, ok)
+			programmed := meta.FindStatusCondition(listenerReport.Status.Conditions, string(gwv1.ListenerConditionProgrammed))
+			require.NotNil(t, programmed)
+			assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+			assert.Contains(t, programmed.Message, tt.wantMessage)
+		})
+	}
+}
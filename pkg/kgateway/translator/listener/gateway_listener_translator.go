@@ -33,6 +33,7 @@ var logger = logging.New("translator/listener")
 
 const (
 	TcpTlsListenerNoBackendsMessage = "TCP/TLS listener has no valid backends or routes"
+	UdpListenerNoBackendsMessage    = "UDP listener has no valid backends or routes"
 	ResourceNotFoundMessageTemplate = "%s %s/%s not found."
 )
 
@@ -119,6 +120,8 @@ func (ml *MergedListeners) AppendListener(
 		ml.AppendTcpListener(listener, routes, reporter)
 	case gwv1.TLSProtocolType:
 		ml.AppendTlsListener(listener, routes, reporter)
+	case gwv1.UDPProtocolType:
+		ml.AppendUdpListener(listener, routes, reporter)
 	default:
 		return fmt.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -281,10 +284,31 @@ func (ml *MergedListeners) AppendTlsListener(
 		port:            finalPort,
 		TcpFilterChains: []tcpFilterChain{fc},
 		listener:        listener,
+		gateway:         ml.parentGw,
 		settings:        ml.settings,
 	})
 }
 
+// AppendUdpListener adds a UDP listener. UDP listeners can't share their port with other listeners,
+// so each one is translated to its own envoy listener.
+func (ml *MergedListeners) AppendUdpListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name: GenerateListenerName(listener),
+		port: getListenerPortNumber(listener),
+		udpListener: &udpListener{
+			routesWithHosts:  routeInfos,
+			listenerReporter: reporter,
+		},
+		listener: listener,
+		gateway:  ml.parentGw,
+		settings: ml.settings,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -305,6 +329,7 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	udpListener       *udpListener
 	listener          ir.Listener
 	gateway           ir.Gateway
 	settings          ListenerTranslatorConfig
@@ -368,6 +393,19 @@ func (ml *MergedListener) TranslateListener(
 		}
 	}
 
+	var udpProxy *ir.UdpIR
+	if ml.udpListener != nil {
+		udpProxy = ml.udpListener.translateUdpListener(ml.name, ml.listener, reporter)
+		if udpProxy == nil {
+			ml.udpListener.listenerReporter.SetCondition(reports.ListenerCondition{
+				Type:    gwv1.ListenerConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.ListenerReasonInvalid,
+				Message: UdpListenerNoBackendsMessage,
+			})
+		}
+	}
+
 	// Get bind address based on ListenerBindIpv6 setting
 	bindAddress := "0.0.0.0"
 	if ml.settings.ListenerBindIpv6 {
@@ -382,6 +420,7 @@ func (ml *MergedListener) TranslateListener(
 		AttachedPolicies:  ir.AttachedPolicies{}, // TODO: find policies attached to listener and attach them <- this might not be possible due to listener merging. also a gw listener ~= envoy filter chain; and i don't believe we need policies there
 		HttpFilterChain:   httpFilterChains,
 		TcpFilterChain:    matchedTcpListeners,
+		UdpProxy:          udpProxy,
 		PolicyAncestorRef: ml.listener.PolicyAncestorRef,
 	}
 }
@@ -501,7 +540,8 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 				FilterChainName: tcpHostName,
 				TLS:             tlsConfig,
 			},
-			BackendRefs: backends,
+			BackendRefs:      backends,
+			AttachedPolicies: tRoute.AttachedPolicies,
 		}
 	case *ir.TlsRouteIR:
 		tRoute := r.Object.(*ir.TlsRouteIR)
@@ -559,18 +599,109 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 			matcher.SniDomains = []string{string(*tc.sniDomain)}
 		}
 
+		// In Terminate mode, envoy terminates TLS with the listener certificates and forwards the
+		// decrypted stream to the backends. Passthrough listeners have no TLS config.
+		resolvedValidation, err := resolveFrontendTLSConfig(tc.parents.listener.Port, frontendTLSConfig)
+		if err != nil {
+			reportTLSConfigError(err, tc.listenerReporter)
+			return nil
+		}
+		tlsConfig, err := translateTLSConfig(kctx, ctx, tc.parents.listener, tc.tls, queries, resolvedValidation)
+		if err != nil {
+			reportTLSConfigError(err, tc.listenerReporter)
+			return nil
+		}
+		if tlsConfig != nil {
+			// match the SNI on the route hostnames that intersect with the listener hostname
+			if hostnames := r.Hostnames(); len(hostnames) > 0 {
+				matcher.SniDomains = hostnames
+			}
+			if len(tlsConfig.AlpnProtocols) == 0 {
+				tlsConfig.AlpnProtocols = []string{string(annotations.AllowEmptyAlpnProtocols)}
+			}
+		}
+
 		return &ir.TcpIR{
 			FilterChainCommon: ir.FilterChainCommon{
 				FilterChainName: tcpHostName,
 				Matcher:         matcher,
+				TLS:             tlsConfig,
 			},
-			BackendRefs: backends,
+			BackendRefs:      backends,
+			AttachedPolicies: tRoute.AttachedPolicies,
 		}
 	default:
 		return nil
 	}
 }
 
+// udpListener represents a Gateway listener with the UDP protocol. Like for TCP, only the oldest
+// UDPRoute attached to the listener is used.
+type udpListener struct {
+	routesWithHosts  []*query.RouteInfo
+	listenerReporter reports.ListenerReporter
+}
+
+func (ul *udpListener) translateUdpListener(parentName string, listener ir.Listener, reporter reports.Reporter) *ir.UdpIR {
+	if len(ul.routesWithHosts) == 0 {
+		return nil
+	}
+	r := slices.MinFunc(ul.routesWithHosts, func(a, b *query.RouteInfo) int {
+		return a.Object.GetSourceObject().GetCreationTimestamp().Compare(b.Object.GetSourceObject().GetCreationTimestamp().Time)
+	})
+	uRoute, ok := r.Object.(*ir.UdpRouteIR)
+	if !ok {
+		return nil
+	}
+
+	condition := reports.RouteCondition{
+		Type:   gwv1.RouteConditionAccepted,
+		Status: metav1.ConditionTrue,
+		Reason: gwv1.RouteReasonAccepted,
+	}
+	if len(uRoute.SourceObject.Spec.Rules) != 1 {
+		condition = reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.RouteReasonUnsupportedValue,
+		}
+	}
+	parentRefReporters := make([]reports.ParentRefReporter, 0, len(uRoute.ParentRefs))
+	for _, parentRef := range uRoute.ParentRefs {
+		parentRefReporter := reporter.Route(uRoute.SourceObject).ParentRef(&parentRef)
+		parentRefReporter.SetCondition(condition)
+		parentRefReporters = append(parentRefReporters, parentRefReporter)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return nil
+	}
+
+	var backends []ir.BackendRefIR
+	for _, backend := range uRoute.Backends {
+		if backend.Err != nil || backend.BackendObject == nil {
+			err := backend.Err
+			if err == nil {
+				err = errors.New("not found")
+			}
+			for _, parentRefReporter := range parentRefReporters {
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+		}
+		// keep invalid backends, so that their share of the sessions fails
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		return nil
+	}
+
+	return &ir.UdpIR{
+		Name:             fmt.Sprintf("%s-%s.%s-rule-%d", parentName, uRoute.Namespace, uRoute.Name, 0),
+		BackendRefs:      backends,
+		AttachedPolicies: uRoute.AttachedPolicies,
+		ParentRef:        listener,
+	}
+}
+
 // httpFilterChain each one represents a GW Listener that has been merged into a single Listener (with distinct filter chains).
 // In the case where no GW Listener merging takes place, every listener will use a MergedListener with 1 HTTP filter chain.
 type httpFilterChain struct {
@@ -62,6 +62,11 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TCPRouteKind,
 			},
 		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 		string(gwv1.ProtocolType(istioprotocol.HBONE)): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
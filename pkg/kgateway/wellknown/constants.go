@@ -32,6 +32,10 @@ const (
 )
 
 const (
+	// XdsClusterName is the name of the cluster of the control plane in the Envoy bootstrap,
+	// used for xDS and for the services served alongside xDS such as the built-in rate limit service.
+	XdsClusterName = "xds_cluster"
+
 	SdsClusterName = "gateway_proxy_sds"
 	SdsTargetURI   = "127.0.0.1:8234"
 )
@@ -46,6 +50,15 @@ const (
 	EnvoyConfigNameMaxLen = 253
 )
 
+const (
+	// WasmModuleMountPath is the directory in the proxy container under which Wasm modules from OCI images
+	// are mounted. Each module is mounted at <WasmModuleMountPath>/<namespace>/<name> of its GatewayExtension.
+	WasmModuleMountPath = "/etc/kgateway/wasm"
+
+	// DefaultWasmModuleFile is the default file name of a Wasm module within an OCI image or ConfigMap.
+	DefaultWasmModuleFile = "plugin.wasm"
+)
+
 // AWS constants for lambda and bedrock configuration
 const (
 	// AccessKey is the key name for in the secret data for the access key id.
@@ -54,6 +67,19 @@ const (
 	SessionToken = "sessionToken"
 	// SecretKey is the key name for in the secret data for the secret access key.
 	SecretKey = "secretKey"
+
+	// AwsWebIdentityTokenPath is the path in the proxy container where the service account token used for
+	// AssumeRoleWithWebIdentity (IRSA) is projected. It matches the path used by the EKS pod identity webhook.
+	AwsWebIdentityTokenPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+	// AwsWebIdentityTokenAudience is the default audience of the web identity token.
+	AwsWebIdentityTokenAudience = "sts.amazonaws.com"
+	// AwsPodIdentityTokenPath is the path in the proxy container where the service account token used for
+	// EKS Pod Identity is projected. It matches the path used by the EKS Pod Identity webhook.
+	AwsPodIdentityTokenPath = "/var/run/secrets/pods.eks.amazonaws.com/serviceaccount/eks-pod-identity-token"
+	// AwsPodIdentityTokenAudience is the audience of the EKS Pod Identity token.
+	AwsPodIdentityTokenAudience = "pods.eks.amazonaws.com"
+	// AwsPodIdentityCredentialsURI is the endpoint of the EKS Pod Identity agent.
+	AwsPodIdentityCredentialsURI = "http://169.254.170.23/v1/credentials"
 )
 
 // OAuth2HMACSecret is the secret that holds the HMAC key for OAuth2
@@ -21,6 +21,7 @@ const (
 	HTTPRouteKind        = "HTTPRoute"
 	TCPRouteKind         = "TCPRoute"
 	TLSRouteKind         = "TLSRoute"
+	UDPRouteKind         = "UDPRoute"
 	GRPCRouteKind        = "GRPCRoute"
 	GatewayKind          = "Gateway"
 	GatewayClassKind     = "GatewayClass"
@@ -94,6 +95,16 @@ var (
 		Version:  gwv1a2.GroupVersion.Version,
 		Resource: "tcproutes",
 	}
+	UDPRouteGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: gwv1a2.GroupVersion.Version,
+		Kind:    UDPRouteKind,
+	}
+	UDPRouteGVR = schema.GroupVersionResource{
+		Group:    GatewayGroup,
+		Version:  gwv1a2.GroupVersion.Version,
+		Resource: "udproutes",
+	}
 	GRPCRouteGVK = schema.GroupVersionKind{
 		Group:   GatewayGroup,
 		Version: gwv1.GroupVersion.Version,
@@ -315,7 +315,13 @@ func convertRetry(
 		}
 	}
 
-	return policy.BuildRetryPolicy(in)
+	retryPolicy, err := policy.BuildRetryPolicy(in)
+	if err != nil {
+		// the retry policy is built from validated HTTPRoute fields, so this should never happen
+		logger.Error("invalid HTTPRoute retry", "error", err)
+		return nil
+	}
+	return retryPolicy
 }
 
 func (r ruleIR) applyRetry(
@@ -557,23 +563,28 @@ func convertMirrorIR(
 	fromns string,
 	refgrants *RefGrantIndex,
 	ups *BackendIndex,
-) *mirrorIr {
+) (*mirrorIr, error) {
 	if f == nil {
-		return nil
+		return nil, nil
 	}
 	to := toFromBackendRef(fromns, f.BackendRef)
 	if !refgrants.ReferenceAllowed(kctx, fromgk, fromns, to) {
-		return nil
+		return nil, nil
 	}
 	up, err := ups.getBackendFromRef(kctx, fromns, f.BackendRef)
 	if err != nil {
-		return nil
+		return nil, nil
+	}
+	// the hosts allowed by the backend are checked on the authority of the request before it's routed,
+	// which doesn't apply to mirrored requests
+	if ir.BackendRestrictsHosts(up) {
+		return nil, fmt.Errorf("requests can't be mirrored to backend %s/%s, since it restricts the hosts requests are forwarded to", up.Namespace, up.Name)
 	}
 	fraction := getFractionPercent(*f)
 	return &mirrorIr{
 		Cluster:         up.ClusterName(),
 		RuntimeFraction: fraction,
-	}
+	}, nil
 }
 
 // HEADER MODIFIER IR
@@ -778,7 +789,10 @@ func (h *RoutesIndex) convertfilterIR(
 	var policy applyToRoute
 	switch f.Type {
 	case gwv1.HTTPRouteFilterRequestMirror:
-		mir := convertMirrorIR(kctx, f.RequestMirror, fromgk, fromns, refgrants, ups)
+		mir, err := convertMirrorIR(kctx, f.RequestMirror, fromgk, fromns, refgrants, ups)
+		if err != nil {
+			return nil, err
+		}
 		if mir != nil {
 			policy = mir
 		}
@@ -339,6 +339,8 @@ type GatewayIndexConfig struct {
 	ListenerSets        krt.Collection[*gwxv1a1.XListenerSet]
 	GatewayClasses      krt.Collection[*gwv1.GatewayClass]
 	Namespaces          krt.Collection[NamespaceMetadata]
+	// Routes is used to find the policies applied to the routes of the gateways, if set
+	Routes *RoutesIndex
 
 	gatewaysForDeployerTransformationFunc func(config *GatewayIndexConfig) func(kctx krt.HandlerContext, gw *gwv1.Gateway) *ir.GatewayForDeployer
 	gatewaysForEnvoyTransformationFunc    func(config *GatewayIndexConfig) func(kctx krt.HandlerContext, gw *gwv1.Gateway) *ir.Gateway
@@ -366,8 +368,15 @@ func GatewaysForDeployerTransformationFunc(config *GatewayIndexConfig) func(kctx
 		if gwClass == nil || !config.ControllerNames.Contains(string(gwClass.Spec.ControllerName)) {
 			return nil
 		}
+		isEnvoy := string(gwClass.Spec.ControllerName) == config.EnvoyControllerName
 		ports := sets.New[int32]()
+		// Envoy serves UDP listeners on UDP ports only, so they are exposed separately.
+		udpPorts := sets.New[int32]()
 		for _, l := range gw.Spec.Listeners {
+			if isEnvoy && l.Protocol == gwv1.UDPProtocolType {
+				udpPorts.Insert(l.Port)
+				continue
+			}
 			ports.Insert(l.Port)
 		}
 
@@ -380,23 +389,107 @@ func GatewaysForDeployerTransformationFunc(config *GatewayIndexConfig) func(kctx
 
 		for _, ls := range listenerSets {
 			for _, l := range ls.Spec.Listeners {
+				if isEnvoy && l.Protocol == gwv1.UDPProtocolType {
+					udpPorts.Insert(l.Port)
+					continue
+				}
 				ports.Insert(l.Port)
 			}
 		}
+		objSrc := ir.ObjectSource{
+			Group:     gwv1.GroupVersion.Group,
+			Kind:      wellknown.GatewayKind,
+			Namespace: gw.Namespace,
+			Name:      gw.Name,
+		}
+
+		// Policies attached to the Gateway, its listeners or its ListenerSets can make the proxy accept
+		// UDP traffic on the ports of HTTPS listeners, in which case the ports must also be exposed over UDP.
+		// The policies applied to the gateway, including those of its routes, may also run Wasm modules
+		// distributed as OCI images, which must be mounted into the proxy pods.
+		var policies []ir.PolicyAtt
+		if config.PolicyIndex != nil && isEnvoy {
+			gwPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, objSrc, "", gw.GetLabels())
+			policies = append(policies, gwPolicies...)
+			for _, l := range gw.Spec.Listeners {
+				listenerPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, objSrc, string(l.Name), gw.GetLabels())
+				udpPorts.Insert(listenerUDPPorts(l, listenerPolicies, gwPolicies)...)
+				policies = append(policies, listenerPolicies...)
+			}
+			for _, ls := range listenerSets {
+				lsSrc := ir.ObjectSource{
+					Group:     wellknown.XListenerSetGroup,
+					Kind:      wellknown.XListenerSetKind,
+					Namespace: ls.Namespace,
+					Name:      ls.Name,
+				}
+				listenerSetPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, lsSrc, "", ls.GetLabels())
+				for _, l := range ls.Spec.Listeners {
+					listenerPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, lsSrc, string(l.Name), ls.GetLabels())
+					udpPorts.Insert(listenerUDPPorts(utils.ToListener(l), listenerPolicies, listenerSetPolicies, gwPolicies)...)
+					policies = append(policies, listenerPolicies...)
+				}
+				policies = append(policies, listenerSetPolicies...)
+				if config.Routes != nil {
+					policies = append(policies, config.Routes.HTTPRoutePoliciesFor(kctx, types.NamespacedName{Namespace: ls.Namespace, Name: ls.Name}, wellknown.XListenerSetGroup, wellknown.XListenerSetKind)...)
+				}
+			}
+			if config.Routes != nil {
+				policies = append(policies, config.Routes.HTTPRoutePoliciesFor(kctx, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}, wellknown.GatewayGroup, wellknown.GatewayKind)...)
+			}
+		}
+
 		ir := &ir.GatewayForDeployer{
-			ObjectSource: ir.ObjectSource{
-				Group:     gwv1.GroupVersion.Group,
-				Kind:      wellknown.GatewayKind,
-				Namespace: gw.Namespace,
-				Name:      gw.Name,
-			},
+			ObjectSource:   objSrc,
 			ControllerName: string(gwClass.Spec.ControllerName),
 			Ports:          smallset.New(ports.UnsortedList()...),
+			UDPPorts:       smallset.New(udpPorts.UnsortedList()...),
+			WasmImages:     wasmImages(policies),
 		}
 		return ir
 	}
 }
 
+// wasmImages returns the images of the Wasm modules run by the given policies, sorted by GatewayExtension.
+func wasmImages(policies []ir.PolicyAtt) []ir.WasmImage {
+	images := map[string]ir.WasmImage{}
+	for _, pol := range policies {
+		if wasmPol, ok := pol.PolicyIr.(ir.WasmImagesPolicyIR); ok {
+			for _, image := range wasmPol.WasmImages() {
+				images[image.GatewayExtension.ResourceName()] = image
+			}
+		}
+	}
+	if len(images) == 0 {
+		return nil
+	}
+	out := make([]ir.WasmImage, 0, len(images))
+	for _, image := range images {
+		out = append(out, image)
+	}
+	return slices.SortBy(out, func(image ir.WasmImage) string {
+		return image.GatewayExtension.ResourceName()
+	})
+}
+
+// listenerUDPPorts returns the port of the listener if it terminates HTTPS and one of its policies
+// makes the proxy accept UDP traffic on it, e.g. for HTTP/3.
+func listenerUDPPorts(l gwv1.Listener, policies ...[]ir.PolicyAtt) []int32 {
+	if l.Protocol != gwv1.HTTPSProtocolType || (l.TLS != nil && l.TLS.Mode != nil && *l.TLS.Mode != gwv1.TLSModeTerminate) {
+		return nil
+	}
+	for _, pols := range policies {
+		for _, pol := range pols {
+			if udpPol, ok := pol.PolicyIr.(ir.UDPPortsPolicyIR); ok {
+				if ports := udpPol.UDPPorts([]int32{l.Port}); len(ports) > 0 {
+					return ports
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func GatewaysForEnvoyTransformationFunc(config *GatewayIndexConfig) func(kctx krt.HandlerContext, gw *gwv1.Gateway) *ir.Gateway {
 	return func(kctx krt.HandlerContext, gw *gwv1.Gateway) *ir.Gateway {
 		// only care about gateways use a class controlled by envoy
@@ -1013,6 +1106,12 @@ func (c RouteWrapper) Equals(in RouteWrapper) bool {
 		} else {
 			return a.Equals(*bhttp)
 		}
+	case *ir.UdpRouteIR:
+		if budp, ok := in.Route.(*ir.UdpRouteIR); !ok {
+			return false
+		} else {
+			return a.Equals(*budp)
+		}
 	}
 	panic("unknown route type")
 }
@@ -1076,6 +1175,7 @@ func NewRoutesIndex(
 	grpcroutes krt.Collection[*gwv1.GRPCRoute],
 	tcproutes krt.Collection[*gwv1a2.TCPRoute],
 	tlsroutes krt.Collection[*gwv1a2.TLSRoute],
+	udproutes krt.Collection[*gwv1a2.UDPRoute],
 	policies *PolicyIndex,
 	backends *BackendIndex,
 	refgrants *RefGrantIndex,
@@ -1088,7 +1188,7 @@ func NewRoutesIndex(
 		weightedRoutePrecedence:              globalSettings.WeightedRoutePrecedence,
 		enableExperimentalGatewayAPIFeatures: globalSettings.EnableExperimentalGatewayAPIFeatures,
 	}
-	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced)
+	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced, udproutes.HasSynced)
 
 	h.httpRouteStatusMarkers, h.httpRoutes = krt.NewStatusCollection(httproutes, func(kctx krt.HandlerContext, i *gwv1.HTTPRoute) (*StatusMarker, *ir.HttpRouteIR) {
 		return h.transformHttpRoute(kctx, i, controllerName)
@@ -1107,11 +1207,16 @@ func NewRoutesIndex(
 		t := h.transformTlsRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-tls-routes-with-policy")...)
+
+	udpRoutesCollection := krt.NewCollection(udproutes, func(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *RouteWrapper {
+		t := h.transformUdpRoute(kctx, i)
+		return &RouteWrapper{Route: t}
+	}, krtopts.ToOptions("routes-udp-routes-with-policy")...)
 	grpcRoutesCollection := krt.NewCollection(grpcroutes, func(kctx krt.HandlerContext, i *gwv1.GRPCRoute) *RouteWrapper {
 		t := h.transformGRPCRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-grpc-routes-with-policy")...)
-	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
+	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection, udpRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
 
 	httpBySelector := krtpkg.UnnamedIndex(h.httpRoutes, func(i ir.HttpRouteIR) []HTTPRouteSelector {
 		value, ok := i.SourceObject.GetLabels()[apilabels.DelegationLabelSelector]
@@ -1187,6 +1292,67 @@ func (h *RoutesIndex) RoutesFor(kctx krt.HandlerContext, nns types.NamespacedNam
 	return ret
 }
 
+// HTTPRoutePoliciesFor returns the policies attached to the HTTP routes of the given parent, to their rules
+// and backends, and to the routes they delegate to. Delegated routes are included whether or not they
+// attach to their parent, so the result may include policies that are not applied to the parent.
+func (h *RoutesIndex) HTTPRoutePoliciesFor(kctx krt.HandlerContext, nns types.NamespacedName, group, kind string) []ir.PolicyAtt {
+	var out []ir.PolicyAtt
+	visited := sets.New[string]()
+	var walk func(rt *ir.HttpRouteIR)
+	walk = func(rt *ir.HttpRouteIR) {
+		if visited.Has(rt.ResourceName()) {
+			return
+		}
+		visited.Insert(rt.ResourceName())
+		out = appendAttachedPolicies(out, rt.AttachedPolicies)
+		for _, rule := range rt.Rules {
+			out = appendAttachedPolicies(out, rule.AttachedPolicies)
+			out = appendAttachedPolicies(out, rule.ExtensionRefs)
+			for _, backend := range rule.Backends {
+				out = appendAttachedPolicies(out, backend.AttachedPolicies)
+				if backend.Delegate == nil {
+					continue
+				}
+				for _, child := range h.fetchDelegatedHTTPRoutes(kctx, *backend.Delegate) {
+					walk(&child)
+				}
+			}
+		}
+	}
+	for _, rt := range h.RoutesFor(kctx, nns, group, kind) {
+		if httpRoute, ok := rt.(*ir.HttpRouteIR); ok {
+			walk(httpRoute)
+		}
+	}
+	return out
+}
+
+// fetchDelegatedHTTPRoutes returns the HTTPRoutes selected by a delegating backend reference.
+func (h *RoutesIndex) fetchDelegatedHTTPRoutes(kctx krt.HandlerContext, ref ir.ObjectSource) []ir.HttpRouteIR {
+	switch {
+	case ref.Group+"/"+ref.Kind == apilabels.DelegationLabelSelector:
+		selector := HTTPRouteSelector{LabelValue: ref.Name}
+		if ref.Namespace != apilabels.DelegationLabelSelectorWildcardNamespace {
+			selector.Namespace = ref.Namespace
+		}
+		return h.FetchHTTPRoutesBySelector(kctx, selector)
+	case ref.Name == "" || ref.Name == "*":
+		return h.FetchHTTPRoutesBySelector(kctx, HTTPRouteSelector{Namespace: ref.Namespace})
+	default:
+		if rt := h.FetchHttp(kctx, ref.Namespace, ref.Name); rt != nil {
+			return []ir.HttpRouteIR{*rt}
+		}
+		return nil
+	}
+}
+
+func appendAttachedPolicies(out []ir.PolicyAtt, pols ir.AttachedPolicies) []ir.PolicyAtt {
+	for _, gk := range pols.ApplyOrderedGroupKinds() {
+		out = append(out, pols.Policies[gk]...)
+	}
+	return out
+}
+
 func (h *RoutesIndex) FetchHttp(kctx krt.HandlerContext, ns, n string) *ir.HttpRouteIR {
 	src := ir.ObjectSource{
 		Group:     gwv1.GroupVersion.Group,
@@ -1260,6 +1426,26 @@ func (h *RoutesIndex) transformTlsRoute(kctx krt.HandlerContext, i *gwv1a2.TLSRo
 	}
 }
 
+func (h *RoutesIndex) transformUdpRoute(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *ir.UdpRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1a2.GroupVersion.Group,
+		Kind:      "UDPRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+	var backends []gwv1.BackendRef
+	if len(i.Spec.Rules) > 0 {
+		backends = i.Spec.Rules[0].BackendRefs
+	}
+	return &ir.UdpRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Backends:         h.getTcpBackends(kctx, src, backends),
+		AttachedPolicies: ToAttachedPolicies(h.policies.GetTargetingPolicies(kctx, src, "", i.GetLabels())),
+	}
+}
+
 func (h *RoutesIndex) transformHttpRoute(kctx krt.HandlerContext, i *gwv1.HTTPRoute, controllerName string) (*StatusMarker, *ir.HttpRouteIR) {
 	src := ir.ObjectSource{
 		Group:     gwv1.GroupVersion.Group,
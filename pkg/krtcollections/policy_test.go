@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -861,8 +862,9 @@ func preRouteIndex(t test.Failer, inputs []any) *RoutesIndex {
 	httproutes := krttest.GetMockCollection[*gwv1.HTTPRoute](mock)
 	tcpproutes := krttest.GetMockCollection[*gwv1a2.TCPRoute](mock)
 	tlsroutes := krttest.GetMockCollection[*gwv1a2.TLSRoute](mock)
+	udproutes := krttest.GetMockCollection[*gwv1a2.UDPRoute](mock)
 	grpcroutes := krttest.GetMockCollection[*gwv1.GRPCRoute](mock)
-	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, wellknown.DefaultGatewayControllerName, httproutes, grpcroutes, tcpproutes, tlsroutes, policies, upstreams, refgrants, apisettings.Settings{})
+	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, wellknown.DefaultGatewayControllerName, httproutes, grpcroutes, tcpproutes, tlsroutes, udproutes, policies, upstreams, refgrants, apisettings.Settings{})
 	services.WaitUntilSynced(nil)
 	policyCol.WaitUntilSynced(nil)
 	for !rtidx.HasSynced() || !refgrants.HasSynced() || !policyCol.HasSynced() {
@@ -1065,3 +1067,103 @@ func BenchmarkPolicyAttachment(b *testing.B) {
 		})
 	}
 }
+
+type udpPortsPolicy struct{}
+
+func (udpPortsPolicy) CreationTime() time.Time        { return time.Time{} }
+func (udpPortsPolicy) Equals(in any) bool             { return in == udpPortsPolicy{} }
+func (udpPortsPolicy) UDPPorts(ports []int32) []int32 { return ports }
+
+func TestListenerUDPPorts(t *testing.T) {
+	udpPolicies := []ir.PolicyAtt{{PolicyIr: udpPortsPolicy{}}}
+	https := gwv1.Listener{Name: "https", Protocol: gwv1.HTTPSProtocolType, Port: 443}
+
+	assert.Equal(t, []int32{443}, listenerUDPPorts(https, nil, udpPolicies))
+	assert.Empty(t, listenerUDPPorts(https, nil, nil))
+	assert.Empty(t, listenerUDPPorts(gwv1.Listener{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 8080}, udpPolicies))
+	assert.Empty(t, listenerUDPPorts(gwv1.Listener{
+		Name:     "tls",
+		Protocol: gwv1.TLSProtocolType,
+		Port:     8443,
+		TLS:      &gwv1.ListenerTLSConfig{Mode: ptr.To(gwv1.TLSModePassthrough)},
+	}, udpPolicies))
+}
+
+type wasmImagesPolicy struct {
+	images []ir.WasmImage
+}
+
+func (wasmImagesPolicy) CreationTime() time.Time      { return time.Time{} }
+func (p wasmImagesPolicy) Equals(in any) bool         { return false }
+func (p wasmImagesPolicy) WasmImages() []ir.WasmImage { return p.images }
+
+func TestWasmImages(t *testing.T) {
+	filter := ir.WasmImage{GatewayExtension: ir.ObjectSource{Namespace: "a", Name: "filter"}, Reference: "ghcr.io/example/filter:v1"}
+	signer := ir.WasmImage{GatewayExtension: ir.ObjectSource{Namespace: "b", Name: "signer"}, Reference: "ghcr.io/example/signer:v1"}
+
+	assert.Nil(t, wasmImages([]ir.PolicyAtt{{PolicyIr: udpPortsPolicy{}}}))
+	assert.Equal(t, []ir.WasmImage{filter, signer}, wasmImages([]ir.PolicyAtt{
+		{PolicyIr: wasmImagesPolicy{images: []ir.WasmImage{signer, filter}}},
+		{PolicyIr: wasmImagesPolicy{images: []ir.WasmImage{filter}}},
+		{PolicyIr: udpPortsPolicy{}},
+	}))
+}
+
+func TestHTTPRoutePoliciesFor(t *testing.T) {
+	route := func(name string, parentRef gwv1.ParentReference, backendRef gwv1.BackendObjectReference) *gwv1.HTTPRoute {
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: []gwv1.ParentReference{parentRef}},
+				Rules: []gwv1.HTTPRouteRule{{
+					BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: gwv1.BackendRef{BackendObjectReference: backendRef}}},
+				}},
+			},
+		}
+	}
+	policy := func(name, route string) ir.PolicyWrapper {
+		return ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     wellknown.TrafficPolicyGVK.Group,
+				Kind:      wellknown.TrafficPolicyGVK.Kind,
+				Namespace: "default",
+				Name:      name,
+			},
+			Policy: &kgateway.TrafficPolicy{},
+			PolicyIR: wasmImagesPolicy{images: []ir.WasmImage{{
+				GatewayExtension: ir.ObjectSource{Namespace: "default", Name: name},
+			}}},
+			TargetRefs: []ir.PolicyRef{{
+				Group: wellknown.GatewayGroup,
+				Kind:  wellknown.HTTPRouteKind,
+				Name:  route,
+			}},
+		}
+	}
+	svc := gwv1.BackendObjectReference{Name: "foo", Port: ptr.To(gwv1.PortNumber(8080))}
+
+	inputs := []any{
+		route("parent", gwv1.ParentReference{Name: "gw"}, gwv1.BackendObjectReference{
+			Group: ptr.To(gwv1.Group(wellknown.GatewayGroup)),
+			Kind:  ptr.To(gwv1.Kind(wellknown.HTTPRouteKind)),
+			Name:  "child",
+		}),
+		route("child", gwv1.ParentReference{
+			Group: ptr.To(gwv1.Group(wellknown.GatewayGroup)),
+			Kind:  ptr.To(gwv1.Kind(wellknown.HTTPRouteKind)),
+			Name:  "parent",
+		}, svc),
+		route("other", gwv1.ParentReference{Name: "other-gw"}, svc),
+		policy("parent-policy", "parent"),
+		policy("child-policy", "child"),
+		policy("other-policy", "other"),
+	}
+	rtidx := preRouteIndex(t, inputs)
+
+	policies := rtidx.HTTPRoutePoliciesFor(krt.TestingDummyContext{}, types.NamespacedName{Namespace: "default", Name: "gw"}, wellknown.GatewayGroup, wellknown.GatewayKind)
+	var names []string
+	for _, image := range wasmImages(policies) {
+		names = append(names, image.GatewayExtension.Name)
+	}
+	assert.Equal(t, []string{"child-policy", "parent-policy"}, names)
+}
@@ -10,6 +10,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/smallset"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -312,8 +313,52 @@ type GatewayForDeployer struct {
 	ObjectSource
 	// Controller name for the gateway
 	ControllerName string
-	// All ports from all listeners
+	// All ports from all listeners, except the UDP listeners of Envoy gateways
 	Ports smallset.Set[int32]
+	// Ports of UDP listeners, and listener ports on which the proxy also accepts UDP traffic, e.g. for HTTP/3
+	UDPPorts smallset.Set[int32]
+	// Wasm modules distributed as OCI images that are used by the policies applied to the gateway,
+	// sorted by GatewayExtension
+	WasmImages []WasmImage
+}
+
+// UDPPortsPolicyIR is implemented by policies that make the proxy accept UDP traffic
+// on listener ports, so that the deployer can expose them.
+type UDPPortsPolicyIR interface {
+	// UDPPorts returns the ports, among the given listener ports, that the proxy accepts UDP traffic on.
+	UDPPorts(ports []int32) []int32
+}
+
+// HostRestrictedBackendIR is implemented by the IR of backends that only forward requests to some hosts.
+// The hosts are checked against the authority of requests before they are routed, so host rewrites on
+// the routes to such backends and request mirrors to them are rejected, since they would bypass the check.
+type HostRestrictedBackendIR interface {
+	// RestrictsHosts returns true if the backend only forwards requests to some hosts.
+	RestrictsHosts() bool
+}
+
+// BackendRestrictsHosts returns true if the backend only forwards requests to some hosts.
+func BackendRestrictsHosts(b *BackendObjectIR) bool {
+	if b == nil {
+		return false
+	}
+	restricted, ok := b.ObjIr.(HostRestrictedBackendIR)
+	return ok && restricted.RestrictsHosts()
+}
+
+// WasmImage is a Wasm module distributed as an OCI image, which the deployer mounts into the proxy pods.
+type WasmImage struct {
+	// GatewayExtension defining the module, which determines where the image is mounted
+	GatewayExtension ObjectSource
+	Reference        string
+	PullPolicy       corev1.PullPolicy
+}
+
+// WasmImagesPolicyIR is implemented by policies that run Wasm modules distributed as OCI images,
+// so that the deployer can mount them into the proxy pods of the gateways the policies apply to.
+type WasmImagesPolicyIR interface {
+	// WasmImages returns the images of the Wasm modules run by the policy.
+	WasmImages() []WasmImage
 }
 
 func (c GatewayForDeployer) ResourceName() string {
@@ -323,7 +368,9 @@ func (c GatewayForDeployer) ResourceName() string {
 func (c GatewayForDeployer) Equals(in GatewayForDeployer) bool {
 	return c.ObjectSource.Equals(in.ObjectSource) &&
 		c.ControllerName == in.ControllerName &&
-		slices.Equal(c.Ports.List(), in.Ports.List())
+		slices.Equal(c.Ports.List(), in.Ports.List()) &&
+		slices.Equal(c.UDPPorts.List(), in.UDPPorts.List()) &&
+		slices.Equal(c.WasmImages, in.WasmImages)
 }
 
 type ListenerForDeployer struct {
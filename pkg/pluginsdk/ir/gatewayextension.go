@@ -29,6 +29,9 @@ type GatewayExtension struct {
 	// OAuth2 configuration for OAuth2 extension type.
 	OAuth2 *kgateway.OAuth2Provider
 
+	// Wasm configuration for Wasm extension type.
+	Wasm *kgateway.WasmProvider
+
 	// PrecedenceWeight specifies the precedence weight associated with the provider.
 	// A higher weight implies higher priority.
 	// It is used to order provider filters by their weight.
@@ -61,6 +64,9 @@ func (e GatewayExtension) Equals(other GatewayExtension) bool {
 	if !reflect.DeepEqual(e.OAuth2, other.OAuth2) {
 		return false
 	}
+	if !reflect.DeepEqual(e.Wasm, other.Wasm) {
+		return false
+	}
 	if e.PrecedenceWeight != other.PrecedenceWeight {
 		return false
 	}
@@ -58,6 +58,8 @@ type ListenerIR struct {
 
 	HttpFilterChain []HttpFilterChainIR
 	TcpFilterChain  []TcpIR
+	// UdpProxy is set for UDP listeners, which have no filter chains.
+	UdpProxy *UdpIR
 
 	PolicyAncestorRef gwv1.ParentReference
 
@@ -137,6 +139,18 @@ type HttpFilterChainIR struct {
 type TcpIR struct {
 	FilterChainCommon
 	BackendRefs []BackendRefIR
+	// AttachedPolicies are the policies attached to the TCPRoute or TLSRoute of the filter chain.
+	AttachedPolicies AttachedPolicies
+}
+
+type UdpIR struct {
+	// Name is the name of the Gateway listener and UDPRoute the proxy is translated from.
+	Name        string
+	BackendRefs []BackendRefIR
+	// AttachedPolicies are the policies attached to the UDPRoute.
+	AttachedPolicies AttachedPolicies
+	// ParentRef is the Gateway listener the proxy is translated from. Used to report status.
+	ParentRef Listener
 }
 
 // this is 1:1 with envoy deployments
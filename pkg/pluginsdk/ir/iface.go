@@ -2,6 +2,7 @@ package ir
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -12,6 +13,7 @@ import (
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyudpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -41,6 +43,10 @@ type HttpFiltersContext struct {
 	ListenerPort uint32
 }
 
+type FinalizeListenerContext struct {
+	Port uint32
+}
+
 type RouteConfigContext struct {
 	Policy            PolicyIR
 	FilterChainName   string
@@ -113,10 +119,34 @@ type RouteContext struct {
 	TypedFilterConfig TypedFilterConfigMap
 	// ListenerPort is the port of the Gateway listener that this route is attached to
 	ListenerPort uint32
+	// PolicyAncestorRef is the ancestor that status of the policies applied to this route is reported on
+	PolicyAncestorRef gwv1.ParentReference
 
 	InheritedPolicyPriority apiannotations.InheritedPolicyPriorityValue
 }
 
+type TcpRouteContext struct {
+	FilterChainName string
+	Policy          PolicyIR
+	// ListenerPort is the port of the Gateway listener that this route is attached to
+	ListenerPort uint32
+	// PolicyAncestorRef is the ancestor that status of the policies applied to this route is reported on
+	PolicyAncestorRef gwv1.ParentReference
+	// BackendRefs are the backends of the route
+	BackendRefs []BackendRefIR
+	// TerminalFilter replaces the tcp_proxy filter of the filter chain when set by a plugin,
+	// e.g. to proxy an application protocol instead of forwarding opaque streams.
+	TerminalFilter *envoylistenerv3.Filter
+}
+
+type UdpRouteContext struct {
+	Policy PolicyIR
+	// ListenerPort is the port of the Gateway listener that this route is attached to
+	ListenerPort uint32
+	// PolicyAncestorRef is the ancestor that status of the policies applied to this route is reported on
+	PolicyAncestorRef gwv1.ParentReference
+}
+
 type HcmContext struct {
 	ListenerPort uint32
 	Policy       PolicyIR
@@ -190,6 +220,27 @@ type ProxyTranslationPass interface {
 		pCtx *HcmContext,
 		out *envoy_hcm.HttpConnectionManager) error
 
+	// FinalizeHCM is called 1 time per HTTP filter chain for every plugin, after the routes of the filter chain
+	// are translated and ApplyHCM is called. It allows tweaking HCM settings based on the state collected while
+	// translating the routes, regardless of the policies attached to the listener.
+	FinalizeHCM(hCtx HttpFiltersContext, fc FilterChainCommon, out *envoy_hcm.HttpConnectionManager) error
+
+	// ApplyForTcpRoute is called 1 time per TCP filter chain for each policy attached to the TCPRoute
+	// or TLSRoute the filter chain is translated from. The returned network filters are added in front
+	// of the tcp_proxy filter, or of the TerminalFilter set on the context.
+	// Policies are applied even if they have errors, unless one of them is a DenyConnectionsError,
+	// in which case all the connections of the filter chain are denied.
+	ApplyForTcpRoute(pCtx *TcpRouteContext) ([]filters.StagedNetworkFilter, error)
+
+	// ApplyForUdpRoute is called 1 time per UDP listener for each policy attached to the UDPRoute
+	// the listener is translated from, and allows tweaking the UDP proxy settings.
+	ApplyForUdpRoute(pCtx *UdpRouteContext, out *envoyudpproxyv3.UdpProxyConfig) error
+
+	// FinalizeListener is called 1 time per listener for every plugin, after the filter chains of the listener
+	// are translated. It allows tweaking the translated filter chains, and returns the additional listeners
+	// derived from the listener, such as HTTP/3 listeners. Plugins must not keep a reference to the listener.
+	FinalizeListener(pCtx *FinalizeListenerContext, out *envoylistenerv3.Listener) ([]*envoylistenerv3.Listener, error)
+
 	// called 1 time (per envoy proxy). replaces GeneratedResources and allows adding clusters to the envoy.
 	ResourcesToAdd() Resources
 }
@@ -241,6 +292,10 @@ func (s UnimplementedProxyTranslationPass) ApplyHCM(pCtx *HcmContext, out *envoy
 	return nil
 }
 
+func (s UnimplementedProxyTranslationPass) FinalizeHCM(hCtx HttpFiltersContext, fc FilterChainCommon, out *envoy_hcm.HttpConnectionManager) error {
+	return nil
+}
+
 func (s UnimplementedProxyTranslationPass) ApplyForBackend(pCtx *RouteBackendContext, in HttpBackend, out *envoyroutev3.Route) error {
 	return nil
 }
@@ -267,13 +322,26 @@ func (s UnimplementedProxyTranslationPass) NetworkFilters() ([]filters.StagedNet
 	return nil, nil
 }
 
+func (s UnimplementedProxyTranslationPass) ApplyForTcpRoute(pCtx *TcpRouteContext) ([]filters.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+func (s UnimplementedProxyTranslationPass) ApplyForUdpRoute(pCtx *UdpRouteContext, out *envoyudpproxyv3.UdpProxyConfig) error {
+	return nil
+}
+
+func (s UnimplementedProxyTranslationPass) FinalizeListener(pCtx *FinalizeListenerContext, out *envoylistenerv3.Listener) ([]*envoylistenerv3.Listener, error) {
+	return nil, nil
+}
+
 func (s UnimplementedProxyTranslationPass) ResourcesToAdd() Resources {
 	return Resources{}
 }
 
 type Resources struct {
-	Clusters []*envoyclusterv3.Cluster
-	Secrets  []*envoytlsv3.Secret
+	Clusters  []*envoyclusterv3.Cluster
+	Secrets   []*envoytlsv3.Secret
+	Listeners []*envoylistenerv3.Listener
 }
 
 type GwTranslationCtx struct{}
@@ -350,6 +418,27 @@ func (c PolicyWrapper) Equals(in PolicyWrapper) bool {
 
 var ErrNotAttachable = fmt.Errorf("policy is not attachable to this object")
 
+// DenyConnectionsError wraps the errors of policies attached to TCPRoutes and TLSRoutes that must deny
+// the connections of the route rather than forwarding them without the policy, e.g. authorization errors.
+// Connections are still forwarded when policies fail with other errors.
+type DenyConnectionsError struct {
+	Err error
+}
+
+func (e DenyConnectionsError) Error() string {
+	return e.Err.Error()
+}
+
+func (e DenyConnectionsError) Unwrap() error {
+	return e.Err
+}
+
+// IsDenyConnectionsError returns true if the error must deny the connections of the TCPRoute or TLSRoute.
+func IsDenyConnectionsError(err error) bool {
+	var denyErr DenyConnectionsError
+	return errors.As(err, &denyErr)
+}
+
 type PolicyRun interface {
 	// Allocate state for single listener+rotue translation pass.
 	NewGatewayTranslationPass(tctx GwTranslationCtx, reporter reporter.Reporter) ProxyTranslationPass
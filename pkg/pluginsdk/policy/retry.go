@@ -1,20 +1,27 @@
 package policy
 
 import (
+	"fmt"
 	"strings"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	previoushostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/apimachinery/pkg/util/sets"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 )
 
-func BuildRetryPolicy(in *kgateway.Retry) *envoyroutev3.RetryPolicy {
+// previousHostsPredicateName is the name of the retry host predicate rejecting the hosts of the previous attempts
+const previousHostsPredicateName = "envoy.retry_host_predicates.previous_hosts"
+
+func BuildRetryPolicy(in *kgateway.Retry) (*envoyroutev3.RetryPolicy, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 	policy := &envoyroutev3.RetryPolicy{
 		RetryOn:              retryOnToString(in.RetryOn, len(in.StatusCodes) > 0),
@@ -31,7 +38,41 @@ func BuildRetryPolicy(in *kgateway.Retry) *envoyroutev3.RetryPolicy {
 		}
 	}
 
-	return policy
+	if len(in.RetriableRequestHeaders) > 0 {
+		headers, err := pluginsdkutils.ToEnvoyHeaderMatchers(in.RetriableRequestHeaders)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retriable request headers: %w", err)
+		}
+		policy.RetriableRequestHeaders = headers
+	}
+
+	if in.HostSelection != nil {
+		predicate, err := utils.MessageToAny(&previoushostsv3.PreviousHostsPredicate{})
+		if err != nil {
+			return nil, err
+		}
+		policy.RetryHostPredicate = []*envoyroutev3.RetryPolicy_RetryHostPredicate{{
+			Name: previousHostsPredicateName,
+			ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: predicate,
+			},
+		}}
+		if in.HostSelection.MaxAttempts != nil {
+			policy.HostSelectionRetryMaxAttempts = int64(*in.HostSelection.MaxAttempts)
+		}
+	}
+
+	return policy, nil
+}
+
+// BuildHedgePolicy returns the Envoy hedge policy of the retry policy, or nil if hedging is not enabled
+func BuildHedgePolicy(in *kgateway.Retry) *envoyroutev3.HedgePolicy {
+	if in == nil || in.HedgeOnPerTryTimeout == nil || !*in.HedgeOnPerTryTimeout {
+		return nil
+	}
+	return &envoyroutev3.HedgePolicy{
+		HedgeOnPerTryTimeout: true,
+	}
 }
 
 // retryOnToString converts a slice of RetryOnCondition to a comma-separated string
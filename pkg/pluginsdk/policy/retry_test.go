@@ -5,15 +5,21 @@ import (
 	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	previoushostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 )
 
 func TestBuildRetryPolicy(t *testing.T) {
@@ -138,14 +144,87 @@ func TestBuildRetryPolicy(t *testing.T) {
 				RetriableStatusCodes: nil,
 			},
 		},
+		{
+			name: "retry policy with retriable request headers",
+			input: &kgateway.Retry{
+				RetryOn:  []kgateway.RetryOnCondition{"5xx"},
+				Attempts: int32(2),
+				RetriableRequestHeaders: []gwv1.HTTPHeaderMatch{
+					{
+						Type:  ptr.To(gwv1.HeaderMatchRegularExpression),
+						Name:  ":method",
+						Value: "GET|HEAD",
+					},
+				},
+			},
+			want: &envoyroutev3.RetryPolicy{
+				RetryOn:    "5xx",
+				NumRetries: wrapperspb.UInt32(2),
+				RetriableRequestHeaders: []*envoyroutev3.HeaderMatcher{
+					{
+						Name: ":method",
+						HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_StringMatch{
+							StringMatch: &envoymatcherv3.StringMatcher{
+								MatchPattern: &envoymatcherv3.StringMatcher_SafeRegex{
+									SafeRegex: &envoymatcherv3.RegexMatcher{Regex: "GET|HEAD"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "retry policy with host selection",
+			input: &kgateway.Retry{
+				RetryOn:       []kgateway.RetryOnCondition{"reset"},
+				Attempts:      int32(3),
+				HostSelection: &kgateway.RetryHostSelection{MaxAttempts: ptr.To(int32(5))},
+			},
+			want: &envoyroutev3.RetryPolicy{
+				RetryOn:    "reset",
+				NumRetries: wrapperspb.UInt32(3),
+				RetryHostPredicate: []*envoyroutev3.RetryPolicy_RetryHostPredicate{
+					{
+						Name: "envoy.retry_host_predicates.previous_hosts",
+						ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &previoushostsv3.PreviousHostsPredicate{}),
+						},
+					},
+				},
+				HostSelectionRetryMaxAttempts: 5,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := assert.New(t)
-			got := BuildRetryPolicy(tt.input)
+			got, err := BuildRetryPolicy(tt.input)
+			a.NoError(err)
 			diff := cmp.Diff(got, tt.want, protocmp.Transform())
 			a.Empty(diff)
 		})
 	}
 }
+
+func TestBuildHedgePolicy(t *testing.T) {
+	a := assert.New(t)
+	a.Nil(BuildHedgePolicy(nil))
+	a.Nil(BuildHedgePolicy(&kgateway.Retry{HedgeOnPerTryTimeout: ptr.To(false)}))
+
+	got := BuildHedgePolicy(&kgateway.Retry{
+		PerTryTimeout:        &metav1.Duration{Duration: time.Second},
+		HedgeOnPerTryTimeout: ptr.To(true),
+	})
+	a.Empty(cmp.Diff(&envoyroutev3.HedgePolicy{HedgeOnPerTryTimeout: true}, got, protocmp.Transform()))
+}
+
+func mustMessageToAny(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := utils.MessageToAny(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -85,6 +85,25 @@ spec:
 `,
 			wantErrors: []string{"at most one of the fields in [http1ProtocolOptions http2ProtocolOptions] may be set"},
 		},
+		{
+			name: "BackendConfigPolicy: httpConnectProxy targeting a Service",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: BackendConfigPolicy
+metadata:
+  name: backend-config-http-connect-proxy-service
+spec:
+  targetRefs:
+  - group: ""
+    kind: Service
+    name: test-service
+  httpConnectProxy:
+    backendRef:
+      name: proxy
+      port: 3128
+`,
+			wantErrors: []string{"httpConnectProxy can only be used when targeting Backend resources"},
+		},
 		{
 			name: "BackendConfigPolicy: HTTP2 protocol options with integer values",
 			input: `---
@@ -257,6 +276,35 @@ spec:
 				"spec.tcpKeepalive.keepAliveTime: Invalid value: \"string\": keepAliveTime must be at least 1 second",
 			},
 		},
+		{
+			name: "BackendConfigPolicy: health check event log path and status ranges",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: BackendConfigPolicy
+metadata:
+  name: backend-config-health-check-event-log
+spec:
+  targetRefs:
+  - group: ""
+    kind: Service
+    name: test-service
+  healthCheck:
+    timeout: 1s
+    interval: 5s
+    unhealthyThreshold: 3
+    healthyThreshold: 2
+    eventLogPath: /etc/envoy/envoy.yaml
+    http:
+      path: /healthz
+      expectedStatuses:
+      - start: 404
+        end: 404
+`,
+			wantErrors: []string{
+				"spec.healthCheck.eventLogPath: Invalid value:",
+				"start must be lower than end",
+			},
+		},
 		{
 			name: "TrafficPolicy: valid target references",
 			input: `---
@@ -295,7 +343,7 @@ spec:
     kind: Deployment
     name: test-deployment
 `,
-			wantErrors: []string{"targetRefs may only reference Gateway, HTTPRoute, or ListenerSet resources"},
+			wantErrors: []string{"targetRefs may only reference Gateway, HTTPRoute, TCPRoute, TLSRoute, UDPRoute, or ListenerSet resources"},
 		},
 		{
 			name: "TrafficPolicy: policy with autoHostRewrite can only target HTTPRoute",
@@ -313,6 +361,23 @@ spec:
 `,
 			wantErrors: []string{"autoHostRewrite can only be used when targeting HTTPRoute resources"},
 		},
+		{
+			name: "TrafficPolicy: policy targeting TCPRoute can't use HTTP fields",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: traffic-policy-tcp-http-fields
+spec:
+  targetRefs:
+  - group: gateway.networking.k8s.io
+    kind: TCPRoute
+    name: test-route
+  timeouts:
+    request: 10s
+`,
+			wantErrors: []string{"only networkAuthorization, redisProxy and udpSession can be used when targeting TCPRoute, TLSRoute or UDPRoute resources"},
+		},
 		{
 			name: "HTTPListenerPolicy: valid target references",
 			input: `---
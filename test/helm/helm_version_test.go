@@ -97,6 +97,14 @@ func TestHelmChartTemplate(t *testing.T) {
   xds:
     tls:
       enabled: true
+`,
+		},
+		{
+			name: "builtin-rate-limit-enabled",
+			valuesYAML: `controller:
+  builtinRateLimit:
+    enabled: true
+    redisUrl: redis://redis.kgateway-system:6379
 `,
 		},
 	}
@@ -31,6 +31,7 @@ var AllCRDs = []schema.GroupVersionResource{
 	gvr.GRPCRoute,
 	gvr.TCPRoute,
 	gvr.TLSRoute,
+	gvr.UDPRoute,
 	gvr.ReferenceGrant,
 	gvr.BackendTLSPolicy,
 	gvr.XListenerSet,